@@ -0,0 +1,46 @@
+package gollie
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/rollick/gollie/services"
+)
+
+// Environment variables read by NewClientFromEnv
+const (
+	EnvAPIKey      = "MOLLIE_API_KEY"
+	EnvAccessToken = "MOLLIE_ACCESS_TOKEN"
+	EnvTestmode    = "MOLLIE_TESTMODE"
+)
+
+// ErrNoCredentials is returned by NewClientFromEnv when neither an API key
+// nor an access token is set
+var ErrNoCredentials = errors.New("gollie: " + EnvAPIKey + " or " + EnvAccessToken + " must be set")
+
+// NewClientFromEnv returns a new Client authenticated with MOLLIE_API_KEY or,
+// if that is not set, MOLLIE_ACCESS_TOKEN. When MOLLIE_TESTMODE is true all
+// requests are made in test mode, for organization access tokens.
+func NewClientFromEnv(opts ...services.ClientOption) (*Client, error) {
+	token := os.Getenv(EnvAPIKey)
+	if token == "" {
+		token = os.Getenv(EnvAccessToken)
+	}
+	if token == "" {
+		return nil, ErrNoCredentials
+	}
+
+	if value := os.Getenv(EnvTestmode); value != "" {
+		testmode, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("gollie: invalid %s %q: %v", EnvTestmode, value, err)
+		}
+		if testmode {
+			opts = append([]services.ClientOption{services.WithDefaultTestmode()}, opts...)
+		}
+	}
+
+	return NewClient(token, opts...), nil
+}
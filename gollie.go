@@ -1,29 +1,76 @@
 // Package gollie is for Mollie API access (partial) using token authentication
+//
+// A Client and its services are safe for concurrent use by multiple
+// goroutines, and should be created once and shared: they hold no per request
+// state, and the shared state of client options (method cache, circuit
+// breaker) is synchronised. List iterators are not safe for concurrent use.
 package gollie
 
-import "github.com/rollick/gollie/services"
+import (
+	"io"
+	"net/http"
+
+	"github.com/rollick/gollie/services"
+)
 
 //
 // Client to wrap services
 //
 
-// Client is a tiny Mollie API client
+// Client is a tiny Mollie API client. It is safe for concurrent use.
 type Client struct {
-	MethodService       *services.MethodService
-	PaymentService      *services.PaymentService
-	CustomerService     *services.CustomerService
-	MandateService      *services.MandateService
-	SubscriptionService *services.SubscriptionService
+	MethodService          *services.MethodService
+	PaymentService         *services.PaymentService
+	CustomerService        *services.CustomerService
+	MandateService         *services.MandateService
+	SubscriptionService    *services.SubscriptionService
+	SettlementService      *services.SettlementService
+	WebhookService         *services.WebhookService
+	BalanceTransferService *services.BalanceTransferService
+	OrganizationService    *services.OrganizationService
+	PermissionService      *services.PermissionService
+	OnboardingService      *services.OnboardingService
+	PaymentLinkService     *services.PaymentLinkService
+	LinkService            *services.LinkService
 	// TODO: Other service endpoints to be added
+
+	opts   []services.ClientOption
+	scopes scopeCache
 }
 
 // NewClient returns a new Client
-func NewClient(accessToken string) *Client {
+func NewClient(accessToken string, opts ...services.ClientOption) *Client {
 	return &Client{
-		MethodService:       services.NewMethodService(accessToken),
-		PaymentService:      services.NewPaymentService(accessToken),
-		CustomerService:     services.NewCustomerService(accessToken),
-		MandateService:      services.NewMandateService(accessToken),
-		SubscriptionService: services.NewSubscriptionService(accessToken),
+		MethodService:          services.NewMethodService(accessToken, opts...),
+		PaymentService:         services.NewPaymentService(accessToken, opts...),
+		CustomerService:        services.NewCustomerService(accessToken, opts...),
+		MandateService:         services.NewMandateService(accessToken, opts...),
+		SubscriptionService:    services.NewSubscriptionService(accessToken, opts...),
+		SettlementService:      services.NewSettlementService(accessToken, opts...),
+		WebhookService:         services.NewWebhookService(accessToken, opts...),
+		BalanceTransferService: services.NewBalanceTransferService(accessToken, opts...),
+		OrganizationService:    services.NewOrganizationService(accessToken, opts...),
+		PermissionService:      services.NewPermissionService(accessToken, opts...),
+		OnboardingService:      services.NewOnboardingService(accessToken, opts...),
+		PaymentLinkService:     services.NewPaymentLinkService(accessToken, opts...),
+		LinkService:            services.NewLinkService(accessToken, opts...),
+		opts:                   opts,
 	}
 }
+
+// WithAccessToken returns a copy of the client using a different access
+// token, eg. a test key for a staging profile. The copy shares the options of
+// the client, and with them its transport and circuit breaker.
+func (c *Client) WithAccessToken(accessToken string) *Client {
+	return NewClient(accessToken, c.opts...)
+}
+
+// Resolve fetches the resource a link points to and decodes it into dst
+func (c *Client) Resolve(link services.Link, dst interface{}) (*http.Response, error) {
+	return c.LinkService.Resolve(link, dst)
+}
+
+// Download streams the document or image a link points to into w
+func (c *Client) Download(link services.Link, w io.Writer) (*http.Response, error) {
+	return c.LinkService.Download(link, w)
+}
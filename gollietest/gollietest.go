@@ -0,0 +1,241 @@
+// Package gollietest provides builders for valid Mollie request fixtures, for
+// use in the tests of projects using gollie
+package gollietest
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/rollick/gollie/services"
+)
+
+// Defaults of the built fixtures
+const (
+	DefaultCurrency    = "EUR"
+	DefaultValue       = "10.00"
+	DefaultRedirectURL = "https://example.com/return"
+	DefaultWebhookURL  = "https://example.com/webhook"
+)
+
+// PaymentBuilder builds a payment request fixture that passes validation.
+// The request is built with services.PaymentBuilder; values it cannot
+// represent exactly, such as an amount with more decimals than its currency,
+// make the builder panic.
+type PaymentBuilder struct {
+	currency    string
+	amount      services.Amount
+	amountSet   bool
+	description string
+	method      string
+	metadata    json.RawMessage
+	customerID  string
+	mandateID   string
+	recurring   bool
+	lines       []services.PaymentLine
+}
+
+// NewTestPayment returns a builder for a payment of 10.00 EUR with a
+// description, redirect and webhook URL
+func NewTestPayment() *PaymentBuilder {
+	return &PaymentBuilder{
+		currency:    DefaultCurrency,
+		amount:      mustAmount(DefaultCurrency, DefaultValue),
+		description: "Test payment",
+	}
+}
+
+// WithAmount sets the currency and amount of the payment. A payment with
+// lines must have the total of the lines as its amount, and the currency of
+// its lines cannot be changed.
+func (b *PaymentBuilder) WithAmount(currency string, value string) *PaymentBuilder {
+	if len(b.lines) > 0 && currency != b.currency {
+		panic(fmt.Sprintf("gollietest: cannot change the currency of a payment with lines from %s to %s", b.currency, currency))
+	}
+	b.currency = currency
+	b.amount = mustAmount(currency, value)
+	b.amountSet = true
+	return b
+}
+
+// WithDescription sets the description of the payment
+func (b *PaymentBuilder) WithDescription(description string) *PaymentBuilder {
+	b.description = description
+	return b
+}
+
+// WithMethod sets the payment method
+func (b *PaymentBuilder) WithMethod(method string) *PaymentBuilder {
+	b.method = method
+	return b
+}
+
+// WithMetadata sets the metadata of the payment. It panics if metadata
+// cannot be encoded.
+func (b *PaymentBuilder) WithMetadata(metadata interface{}) *PaymentBuilder {
+	var request services.PaymentRequest
+	if err := request.SetMetadata(metadata); err != nil {
+		panic(err)
+	}
+	b.metadata = request.Metadata
+	return b
+}
+
+// WithCustomer creates the payment for a customer, as the first payment of
+// a mandate
+func (b *PaymentBuilder) WithCustomer(customerId string) *PaymentBuilder {
+	b.customerID = customerId
+	b.mandateID = ""
+	b.recurring = false
+	return b
+}
+
+// Recurring makes the payment a recurring payment on a customer mandate
+func (b *PaymentBuilder) Recurring(customerId string, mandateId string) *PaymentBuilder {
+	b.customerID = customerId
+	b.mandateID = mandateId
+	b.recurring = true
+	return b
+}
+
+// WithLine adds a physical product line of quantity items at unitPrice. The
+// payment amount is the total of the lines.
+func (b *PaymentBuilder) WithLine(description string, quantity int, unitPrice string) *PaymentBuilder {
+	price := mustAmount(b.currency, unitPrice)
+
+	b.lines = append(b.lines, services.PaymentLine{
+		Type:        services.LineTypePhysical,
+		Description: description,
+		Quantity:    quantity,
+		UnitPrice:   price,
+		TotalAmount: services.AmountFromMinor(b.currency, mustMinor(price)*int64(quantity)),
+	})
+	return b
+}
+
+// WithDiscount adds a discount line of amount (a positive value). The
+// payment amount is the total of the lines.
+func (b *PaymentBuilder) WithDiscount(description string, amount string) *PaymentBuilder {
+	discount := services.AmountFromMinor(b.currency, -mustMinor(mustAmount(b.currency, amount)))
+
+	b.lines = append(b.lines, services.PaymentLine{
+		Type:        services.LineTypeDiscount,
+		Description: description,
+		Quantity:    1,
+		UnitPrice:   discount,
+		TotalAmount: discount,
+	})
+	return b
+}
+
+// Build returns the payment request. It panics if the request does not pass
+// validation, or if an amount set with WithAmount is not the total of the
+// lines.
+func (b *PaymentBuilder) Build() services.PaymentRequest {
+	amount := b.amount
+	if len(b.lines) > 0 {
+		var total int64
+		for _, line := range b.lines {
+			total += mustMinor(line.TotalAmount)
+		}
+		amount = services.AmountFromMinor(b.currency, total)
+		if b.amountSet && amount != b.amount {
+			panic(fmt.Sprintf("gollietest: payment amount %s is not the total %s of its lines", b.amount, amount))
+		}
+	}
+
+	builder := services.NewPayment(amount).
+		Description(b.description).
+		Webhook(DefaultWebhookURL)
+	if b.method != "" {
+		builder.Method(b.method)
+	}
+	if b.metadata != nil {
+		builder.Metadata(b.metadata)
+	}
+	for _, line := range b.lines {
+		builder.Line(line)
+	}
+	switch {
+	case b.recurring:
+		builder.Recurring(b.customerID, b.mandateID)
+	case b.customerID != "":
+		builder.First(b.customerID).Redirect(DefaultRedirectURL)
+	default:
+		builder.Redirect(DefaultRedirectURL)
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		panic(fmt.Sprintf("gollietest: invalid payment fixture: %v", err))
+	}
+
+	return request
+}
+
+// mustAmount returns the amount of value in currency, panicking for values
+// with more decimals than the currency has rather than rounding them
+func mustAmount(currency string, value string) services.Amount {
+	minor, err := services.Amount{Currency: currency, Number: value}.Minor()
+	if err != nil {
+		panic(fmt.Sprintf("gollietest: %v", err))
+	}
+
+	return services.AmountFromMinor(currency, minor)
+}
+
+// mustMinor returns the amount in minor units, panicking for amounts the
+// builders did not create
+func mustMinor(amount services.Amount) int64 {
+	minor, err := amount.Minor()
+	if err != nil {
+		panic(err)
+	}
+	return minor
+}
+
+// CustomerBuilder builds a customer request fixture
+type CustomerBuilder struct {
+	request services.CustomerRequest
+}
+
+// NewTestCustomer returns a builder for a customer with a name and email
+func NewTestCustomer() *CustomerBuilder {
+	return &CustomerBuilder{
+		request: services.CustomerRequest{
+			Name:  "Test Customer",
+			Email: "customer@example.com",
+		},
+	}
+}
+
+// WithName sets the name of the customer
+func (b *CustomerBuilder) WithName(name string) *CustomerBuilder {
+	b.request.Name = name
+	return b
+}
+
+// WithEmail sets the email address of the customer
+func (b *CustomerBuilder) WithEmail(email string) *CustomerBuilder {
+	b.request.Email = email
+	return b
+}
+
+// WithLocale sets the locale of the customer
+func (b *CustomerBuilder) WithLocale(locale string) *CustomerBuilder {
+	b.request.Locale = locale
+	return b
+}
+
+// WithMetadata sets the metadata of the customer. It panics if metadata
+// cannot be encoded.
+func (b *CustomerBuilder) WithMetadata(metadata interface{}) *CustomerBuilder {
+	if err := b.request.SetMetadata(metadata); err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// Build returns the customer request
+func (b *CustomerBuilder) Build() services.CustomerRequest {
+	return b.request
+}
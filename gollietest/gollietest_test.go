@@ -0,0 +1,129 @@
+package gollietest
+
+import (
+	"testing"
+
+	"github.com/rollick/gollie/services"
+)
+
+func TestPaymentBuilder(t *testing.T) {
+	tests := []struct {
+		name     string
+		build    func() *PaymentBuilder
+		amount   services.Amount
+		lines    int
+		redirect bool
+	}{
+		{"default", NewTestPayment, services.MustAmount("EUR", "10.00"), 0, true},
+		{"amount", func() *PaymentBuilder {
+			return NewTestPayment().WithAmount("JPY", "1000")
+		}, services.MustAmount("JPY", "1000"), 0, true},
+		{"whole amount", func() *PaymentBuilder {
+			return NewTestPayment().WithAmount("EUR", "25")
+		}, services.MustAmount("EUR", "25.00"), 0, true},
+		{"lines", func() *PaymentBuilder {
+			return NewTestPayment().WithLine("Shirt", 3, "19.99").WithDiscount("Coupon", "5.00")
+		}, services.MustAmount("EUR", "54.97"), 2, true},
+		{"lines in currency", func() *PaymentBuilder {
+			return NewTestPayment().WithAmount("JPY", "3000").WithLine("Tea", 2, "1500")
+		}, services.MustAmount("JPY", "3000"), 1, true},
+		{"first", func() *PaymentBuilder {
+			return NewTestPayment().WithCustomer("cst_1")
+		}, services.MustAmount("EUR", "10.00"), 0, true},
+		{"recurring", func() *PaymentBuilder {
+			return NewTestPayment().Recurring("cst_1", "mdt_1")
+		}, services.MustAmount("EUR", "10.00"), 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := tt.build().Build()
+			if err := request.Validate(); err != nil {
+				t.Fatal(err)
+			}
+			if request.Amount != tt.amount {
+				t.Errorf("got amount %v, want %v", request.Amount, tt.amount)
+			}
+			if len(request.Lines) != tt.lines {
+				t.Errorf("got %d lines, want %d", len(request.Lines), tt.lines)
+			}
+			if (request.RedirectUrl != "") != tt.redirect {
+				t.Errorf("got redirect URL %q", request.RedirectUrl)
+			}
+			if request.WebhookUrl != DefaultWebhookURL {
+				t.Errorf("got webhook URL %q", request.WebhookUrl)
+			}
+		})
+	}
+}
+
+func TestPaymentBuilderFields(t *testing.T) {
+	request := NewTestPayment().
+		WithDescription("Order 1").
+		WithMethod(services.MethodIDEAL).
+		WithMetadata(map[string]string{"order": "1"}).
+		WithCustomer("cst_1").
+		Build()
+
+	if request.Description != "Order 1" || request.Method != services.MethodIDEAL {
+		t.Errorf("got description %q and method %q", request.Description, request.Method)
+	}
+	if string(request.Metadata) != `{"order":"1"}` {
+		t.Errorf("got metadata %s", request.Metadata)
+	}
+	if request.CustomerID != "cst_1" || request.SequenceType != services.SequenceTypeFirst {
+		t.Errorf("got customer %q with sequence type %q", request.CustomerID, request.SequenceType)
+	}
+}
+
+func TestPaymentBuilderPanics(t *testing.T) {
+	tests := []struct {
+		name  string
+		build func()
+	}{
+		{"too many decimals", func() {
+			NewTestPayment().WithAmount("EUR", "10.005")
+		}},
+		{"decimals for currency without", func() {
+			NewTestPayment().WithAmount("JPY", "10.50")
+		}},
+		{"not a number", func() {
+			NewTestPayment().WithAmount("EUR", "ten")
+		}},
+		{"line price with too many decimals", func() {
+			NewTestPayment().WithLine("Shirt", 1, "19.999")
+		}},
+		{"currency change with lines", func() {
+			NewTestPayment().WithLine("Shirt", 1, "19.99").WithAmount("USD", "19.99")
+		}},
+		{"amount not the line total", func() {
+			NewTestPayment().WithAmount("EUR", "20.00").WithLine("Shirt", 1, "19.99").Build()
+		}},
+		{"invalid currency", func() {
+			NewTestPayment().WithAmount("EURO", "10.00").Build()
+		}},
+		{"no description", func() {
+			NewTestPayment().WithDescription("").Build()
+		}},
+		{"no line quantity", func() {
+			NewTestPayment().WithLine("Shirt", 0, "19.99").Build()
+		}},
+		{"recurring without customer", func() {
+			NewTestPayment().Recurring("", "mdt_1").Build()
+		}},
+		{"unencodable metadata", func() {
+			NewTestPayment().WithMetadata(func() {})
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("expected a panic")
+				}
+			}()
+			tt.build()
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package gollietest
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/rollick/gollie/services"
+)
+
+// PostWebhook posts a classic Mollie webhook call, the form encoded id of a
+// resource such as tr_xxx, to handler and returns the recorded response
+func PostWebhook(handler http.Handler, id string) *httptest.ResponseRecorder {
+	form := url.Values{"id": {id}}
+	req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	return rec
+}
+
+// PostEvent posts an event webhook call to handler, signed with secret if it
+// is not "", and returns the recorded response
+func PostEvent(handler http.Handler, event services.Event, secret string) *httptest.ResponseRecorder {
+	body, err := json.Marshal(event)
+	if err != nil {
+		panic(err)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if secret != "" {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(body)
+		req.Header.Set("X-Mollie-Signature", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	return rec
+}
+
+// RecordingSink is a services.Sink recording the webhook calls it handles,
+// failing the first Failures calls to exercise retries
+type RecordingSink struct {
+	Failures int
+
+	mu       sync.Mutex
+	attempts int
+	calls    []services.WebhookCall
+}
+
+// Handle records the webhook call
+func (s *RecordingSink) Handle(ctx context.Context, call services.WebhookCall) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.attempts++
+	if s.attempts <= s.Failures {
+		return errSinkFailure
+	}
+	s.calls = append(s.calls, call)
+
+	return nil
+}
+
+// Calls returns the webhook calls handled successfully
+func (s *RecordingSink) Calls() []services.WebhookCall {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]services.WebhookCall(nil), s.calls...)
+}
+
+// Attempts returns the number of times the sink was called, including failures
+func (s *RecordingSink) Attempts() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.attempts
+}
+
+// errSinkFailure is the error of a RecordingSink failure
+var errSinkFailure = errors.New("gollietest: simulated sink failure")
@@ -0,0 +1,74 @@
+package gollie
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/rollick/gollie/services"
+)
+
+// PingStatus is the result of Ping
+type PingStatus int
+
+// Ping results
+const (
+	// PingOK is returned when Mollie accepted the request
+	PingOK PingStatus = iota
+	// PingAuthError is returned when Mollie rejected the access token
+	PingAuthError
+	// PingNetworkError is returned when Mollie could not be reached, timed
+	// out, answered through a failing proxy or edge, or requests are
+	// suspended by the circuit breaker
+	PingNetworkError
+	// PingAPIError is returned for any other Mollie error, such as a rate
+	// limit or a server error
+	PingAPIError
+)
+
+// String returns the name of the ping status
+func (s PingStatus) String() string {
+	switch s {
+	case PingOK:
+		return "ok"
+	case PingAuthError:
+		return "auth error"
+	case PingNetworkError:
+		return "network error"
+	case PingAPIError:
+		return "api error"
+	}
+
+	return "unknown"
+}
+
+// Ping makes a cheap authenticated request, listing a single payment, and
+// classifies the result, for readiness probes. The error is that of the
+// request, nil for PingOK.
+func (c *Client) Ping(ctx context.Context) (PingStatus, error) {
+	params := &services.ListParams{Limit: 1}
+	_, _, err := c.PaymentService.List(params, services.WithContext(ctx))
+
+	return pingStatus(err), err
+}
+
+// pingStatus classifies the error of a ping request
+func pingStatus(err error) PingStatus {
+	if err == nil {
+		return PingOK
+	}
+
+	var mollieError *services.MollieError
+	if errors.As(err, &mollieError) {
+		if mollieError.Status == http.StatusUnauthorized || mollieError.Status == http.StatusForbidden {
+			return PingAuthError
+		}
+		return PingAPIError
+	}
+	var rateLimitError *services.RateLimitError
+	if errors.As(err, &rateLimitError) {
+		return PingAPIError
+	}
+
+	return PingNetworkError
+}
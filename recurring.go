@@ -0,0 +1,153 @@
+package gollie
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rollick/gollie/services"
+)
+
+// DefaultPollInterval is the interval at which the first payment is checked
+// when bootstrapping recurring billing
+const DefaultPollInterval = 5 * time.Second
+
+// ErrFirstPaymentFailed is returned when the first payment of a recurring
+// billing bootstrap ends without being paid
+var ErrFirstPaymentFailed = errors.New("first payment was not paid")
+
+// RecurringRequest is the customer, first payment and subscription to set up
+// recurring billing with. The first payment is always sent with sequence
+// type first, and the subscription uses the mandate it creates.
+type RecurringRequest struct {
+	Customer     services.CustomerRequest
+	FirstPayment services.PaymentRequest
+	Subscription services.SubscriptionRequest
+
+	// PollInterval is the interval at which the first payment is checked,
+	// DefaultPollInterval if zero
+	PollInterval time.Duration
+}
+
+// RecurringHooks are called as each step of the recurring billing bootstrap
+// completes. All hooks are optional.
+type RecurringHooks struct {
+	// CustomerCreated is called with the new customer
+	CustomerCreated func(services.Customer)
+	// FirstPaymentCreated is called with the first payment, whose checkout
+	// URL the customer must be sent to
+	FirstPaymentCreated func(services.Payment)
+	// MandateValid is called with the mandate created by the first payment
+	MandateValid func(services.Mandate)
+}
+
+// RecurringResult holds the resources created when bootstrapping recurring
+// billing. On error it holds those created before the failing step.
+type RecurringResult struct {
+	Customer     *services.Customer
+	FirstPayment *services.Payment
+	Mandate      *services.Mandate
+	Subscription *services.Subscription
+}
+
+// BootstrapRecurring runs the standard Mollie recurring flow: it creates a
+// customer and a first payment, waits until the payment is paid and has
+// created a usable mandate, then creates the subscription on that mandate.
+// Waiting for the customer to pay can take long; bound it with ctx.
+func (c *Client) BootstrapRecurring(ctx context.Context, request RecurringRequest, hooks RecurringHooks) (*RecurringResult, error) {
+	result := new(RecurringResult)
+	interval := request.PollInterval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+
+	customer, _, err := c.CustomerService.Create(&request.Customer, services.WithContext(ctx))
+	if err != nil {
+		return result, err
+	}
+	result.Customer = &customer
+	if hooks.CustomerCreated != nil {
+		hooks.CustomerCreated(customer)
+	}
+
+	paymentRequest := request.FirstPayment
+	paymentRequest.SequenceType = services.SequenceTypeFirst
+	payment, _, err := c.CustomerService.Payment(customer.ID, paymentRequest, services.WithContext(ctx))
+	if err != nil {
+		return result, err
+	}
+	result.FirstPayment = &payment
+	if hooks.FirstPaymentCreated != nil {
+		hooks.FirstPaymentCreated(payment)
+	}
+
+	mandate, err := c.awaitMandate(ctx, result, interval)
+	if err != nil {
+		return result, err
+	}
+	result.Mandate = &mandate
+	if hooks.MandateValid != nil {
+		hooks.MandateValid(mandate)
+	}
+
+	subscriptionRequest := request.Subscription
+	subscriptionRequest.MandateID = mandate.ID
+	subscription, _, err := c.SubscriptionService.Create(customer.ID, &subscriptionRequest, services.WithContext(ctx))
+	if err != nil {
+		return result, err
+	}
+	result.Subscription = &subscription
+
+	return result, nil
+}
+
+// awaitMandate polls the first payment until it is paid, then returns the
+// usable mandate it created
+func (c *Client) awaitMandate(ctx context.Context, result *RecurringResult, interval time.Duration) (services.Mandate, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		switch result.FirstPayment.Status {
+		case services.PaymentStatusPaid:
+			mandate, err := c.firstPaymentMandate(ctx, result)
+			if err != services.ErrNoValidMandate {
+				return mandate, err
+			}
+		case services.PaymentStatusCanceled, services.PaymentStatusExpired, services.PaymentStatusFailed:
+			return services.Mandate{}, fmt.Errorf("%w: %s", ErrFirstPaymentFailed, result.FirstPayment.Status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return services.Mandate{}, ctx.Err()
+		case <-ticker.C:
+		}
+
+		payment, _, err := c.PaymentService.Fetch(result.FirstPayment.ID, services.WithContext(ctx))
+		if err != nil {
+			return services.Mandate{}, err
+		}
+		result.FirstPayment = &payment
+	}
+}
+
+// firstPaymentMandate returns the mandate created by the paid first payment,
+// or ErrNoValidMandate if it is not usable yet. Payments that do not report
+// their mandate fall back to any usable mandate of the customer.
+func (c *Client) firstPaymentMandate(ctx context.Context, result *RecurringResult) (services.Mandate, error) {
+	if result.FirstPayment.MandateID == "" {
+		return c.CustomerService.FindValidMandate(ctx, result.Customer.ID, "")
+	}
+
+	mandate, _, err := c.MandateService.Fetch(result.Customer.ID, result.FirstPayment.MandateID, services.WithContext(ctx))
+	if err != nil {
+		return services.Mandate{}, err
+	}
+	if !mandate.IsUsable() {
+		return services.Mandate{}, services.ErrNoValidMandate
+	}
+
+	return mandate, nil
+}
@@ -0,0 +1,86 @@
+package gollie
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/rollick/gollie/services"
+)
+
+func TestBootstrapRecurring(t *testing.T) {
+	tests := []struct {
+		name      string
+		payment   string
+		mandates  string
+		mandateID string
+	}{
+		{
+			name:      "payment mandate",
+			payment:   `{"id":"tr_1","status":"paid","mandateId":"mdt_2"}`,
+			mandates:  `{"count":1,"_embedded":{"mandates":[{"id":"mdt_1","status":"valid"}]}}`,
+			mandateID: "mdt_2",
+		},
+		{
+			name:      "customer mandate",
+			payment:   `{"id":"tr_1","status":"paid"}`,
+			mandates:  `{"count":2,"_embedded":{"mandates":[{"id":"mdt_0","status":"invalid"},{"id":"mdt_1","status":"valid"}]}}`,
+			mandateID: "mdt_1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/hal+json")
+				switch r.Method + " " + r.URL.Path {
+				case "POST /v2/customers":
+					w.Write([]byte(`{"id":"cst_1"}`))
+				case "POST /v2/customers/cst_1/payments":
+					w.Write([]byte(tt.payment))
+				case "GET /v2/customers/cst_1/mandates":
+					w.Write([]byte(tt.mandates))
+				case "GET /v2/customers/cst_1/mandates/mdt_2":
+					w.Write([]byte(`{"id":"mdt_2","status":"valid","method":"creditcard"}`))
+				case "POST /v2/customers/cst_1/subscriptions":
+					var body services.SubscriptionRequest
+					if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+						t.Error(err)
+					}
+					w.Write([]byte(`{"id":"sub_1","status":"active"}`))
+					if body.MandateID != tt.mandateID {
+						t.Errorf("subscription created on mandate %q, want %q", body.MandateID, tt.mandateID)
+					}
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			result, err := client.BootstrapRecurring(ctx, RecurringRequest{
+				FirstPayment: services.PaymentRequest{
+					Amount:      services.MustAmount("EUR", "10.00"),
+					Description: "First payment",
+					RedirectUrl: "https://example.com/return",
+				},
+				Subscription: services.SubscriptionRequest{
+					Amount:   services.MustAmount("EUR", "10.00"),
+					Interval: services.Interval{Count: 1, Unit: services.IntervalMonths},
+				},
+			}, RecurringHooks{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.Mandate.ID != tt.mandateID {
+				t.Errorf("got mandate %s, want %s", result.Mandate.ID, tt.mandateID)
+			}
+			if result.Subscription == nil || result.Subscription.ID != "sub_1" {
+				t.Errorf("got subscription %v", result.Subscription)
+			}
+		})
+	}
+}
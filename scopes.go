@@ -0,0 +1,74 @@
+package gollie
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/rollick/gollie/services"
+)
+
+// MissingScopesError is returned by RequireScopes when the access token was
+// not granted some of the required scopes
+type MissingScopesError struct {
+	Scopes []string
+}
+
+// Error lists the missing scopes
+func (e *MissingScopesError) Error() string {
+	return fmt.Sprintf("gollie: access token was not granted %s", strings.Join(e.Scopes, ", "))
+}
+
+// scopeCache caches the scopes granted to the access token
+type scopeCache struct {
+	mu      sync.Mutex
+	granted map[string]bool
+}
+
+// RequireScopes checks the access token was granted all scopes, eg.
+// "payments.write", failing with a MissingScopesError listing those it was
+// not. The granted scopes are fetched from the Permissions API on the first
+// call and cached, so a misconfigured token can be caught at startup. Only
+// OAuth access tokens can use the Permissions API.
+func (c *Client) RequireScopes(ctx context.Context, scopes ...string) error {
+	granted, err := c.grantedScopes(ctx)
+	if err != nil {
+		return err
+	}
+
+	var missing []string
+	for _, scope := range scopes {
+		if !granted[scope] {
+			missing = append(missing, scope)
+		}
+	}
+	if len(missing) > 0 {
+		return &MissingScopesError{Scopes: missing}
+	}
+
+	return nil
+}
+
+// grantedScopes returns the scopes granted to the access token, fetching
+// them if they are not cached yet. Failed fetches are not cached.
+func (c *Client) grantedScopes(ctx context.Context) (map[string]bool, error) {
+	c.scopes.mu.Lock()
+	defer c.scopes.mu.Unlock()
+
+	if c.scopes.granted != nil {
+		return c.scopes.granted, nil
+	}
+
+	permissions, _, err := c.PermissionService.List(services.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	granted := make(map[string]bool, len(permissions.Items))
+	for _, permission := range permissions.Items {
+		granted[permission.ID] = permission.Granted
+	}
+	c.scopes.granted = granted
+
+	return granted, nil
+}
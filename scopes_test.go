@@ -0,0 +1,42 @@
+package gollie
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestRequireScopesConcurrent(t *testing.T) {
+	var calls atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/hal+json")
+		w.Write([]byte(`{"count":2,"_embedded":{"permissions":[
+			{"id":"payments.read","granted":true},
+			{"id":"payments.write","granted":false}]}}`))
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := client.RequireScopes(context.Background(), "payments.read"); err != nil {
+				t.Error(err)
+			}
+			var missing *MissingScopesError
+			err := client.RequireScopes(context.Background(), "payments.read", "payments.write")
+			if !errors.As(err, &missing) || len(missing.Scopes) != 1 || missing.Scopes[0] != "payments.write" {
+				t.Errorf("got %v, want payments.write missing", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := calls.Load(); n != 1 {
+		t.Errorf("permissions fetched %d times, want 1", n)
+	}
+}
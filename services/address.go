@@ -0,0 +1,41 @@
+package services
+
+// Address is a billing or shipping address
+// https://docs.mollie.com/guides/common-data-types#address-object
+type Address struct {
+	OrganizationName string `json:"organizationName,omitempty" bson:"organizationName,omitempty"`
+	Title            string `json:"title,omitempty" bson:"title,omitempty"`
+	GivenName        string `json:"givenName,omitempty" bson:"givenName,omitempty"`
+	FamilyName       string `json:"familyName,omitempty" bson:"familyName,omitempty"`
+	Email            string `json:"email,omitempty" bson:"email,omitempty"`
+	Phone            string `json:"phone,omitempty" bson:"phone,omitempty"`
+	StreetAndNumber  string `json:"streetAndNumber,omitempty" bson:"streetAndNumber,omitempty"`
+	StreetAdditional string `json:"streetAdditional,omitempty" bson:"streetAdditional,omitempty"`
+	PostalCode       string `json:"postalCode,omitempty" bson:"postalCode,omitempty"`
+	City             string `json:"city,omitempty" bson:"city,omitempty"`
+	Region           string `json:"region,omitempty" bson:"region,omitempty"`
+	Country          string `json:"country,omitempty" bson:"country,omitempty"`
+}
+
+// Company entity types
+const (
+	EntityTypeLimitedCompany                   = "limited-company"
+	EntityTypePublicLimitedCompany             = "public-limited-company"
+	EntityTypeEntrepreneurialCompany           = "entrepreneurial-company"
+	EntityTypeLimitedPartnershipLimitedCompany = "limited-partnership-limited-company"
+	EntityTypeLimitedPartnership               = "limited-partnership"
+	EntityTypeGeneralPartnership               = "general-partnership"
+	EntityTypeRegisteredSoleTrader             = "registered-sole-trader"
+	EntityTypeSoleTrader                       = "sole-trader"
+	EntityTypeCivilLawPartnership              = "civil-law-partnership"
+	EntityTypePublicInstitution                = "public-institution"
+)
+
+// Company is the company details of a business customer, required for B2B
+// methods such as Billie
+// https://docs.mollie.com/reference/v2/payments-api/create-payment#billie
+type Company struct {
+	RegistrationNumber string `json:"registrationNumber,omitempty" bson:"registrationNumber,omitempty"`
+	VatNumber          string `json:"vatNumber,omitempty" bson:"vatNumber,omitempty"`
+	EntityType         string `json:"entityType,omitempty" bson:"entityType,omitempty"`
+}
@@ -0,0 +1,292 @@
+package services
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/rollick/decimal"
+)
+
+// currencyDecimals are the number of decimals for currencies that do not use
+// the default of 2
+var currencyDecimals = map[string]int32{
+	"ISK": 0,
+	"JPY": 0,
+}
+
+// Amount is an amount of money in a currency, with the value as a string
+// holding the exact number of decimals for the currency. The value is held in
+// Number, sent to Mollie as "value", as Value is the driver.Valuer method.
+// https://docs.mollie.com/guides/common-data-types#amount-object
+type Amount struct {
+	Currency string `json:"currency" url:"currency" bson:"currency"`
+	Number   string `json:"value" url:"value" bson:"value"`
+}
+
+// NewAmount returns the Amount for value in currency, eg. "10.5" in EUR,
+// formatted with the number of decimals for the currency
+func NewAmount(currency string, value string) (Amount, error) {
+	d, err := decimal.NewFromString(value)
+	if err != nil {
+		return Amount{}, fmt.Errorf("invalid amount value %q: %v", value, err)
+	}
+
+	return newAmount(currency, d), nil
+}
+
+// AmountFromMinor returns the Amount for minor units of currency, eg. 1050
+// cents for EUR 10.50
+func AmountFromMinor(currency string, minor int64) Amount {
+	return newAmount(currency, decimal.New(minor, -Decimals(currency)))
+}
+
+// newAmount returns an Amount for value in currency, formatted with the
+// number of decimals for the currency. Decimals are an internal detail:
+// they are not part of the API.
+func newAmount(currency string, value decimal.Decimal) Amount {
+	currency = strings.ToUpper(currency)
+
+	return Amount{
+		Currency: currency,
+		Number:   value.StringFixed(Decimals(currency)),
+	}
+}
+
+// Decimals returns the number of decimals Mollie expects for currency
+func Decimals(currency string) int32 {
+	if decimals, ok := currencyDecimals[strings.ToUpper(currency)]; ok {
+		return decimals
+	}
+
+	return 2
+}
+
+// String returns the amount as the currency and value, eg. "EUR 10.00"
+func (a Amount) String() string {
+	return a.Currency + " " + a.Number
+}
+
+// Validate returns an error if Mollie would reject the amount
+func (a Amount) Validate() error {
+	if len(a.Currency) != 3 || strings.ToUpper(a.Currency) != a.Currency {
+		return fmt.Errorf("invalid amount currency %q", a.Currency)
+	}
+	if isFixed(a.Number, Decimals(a.Currency)) {
+		return nil
+	}
+
+	value, err := decimal.NewFromString(a.Number)
+	if err != nil {
+		return fmt.Errorf("invalid amount value %q: %v", a.Number, err)
+	}
+	if expected := value.StringFixed(Decimals(a.Currency)); expected != a.Number {
+		return fmt.Errorf("invalid amount value %q for %s, expected %q", a.Number, a.Currency, expected)
+	}
+
+	return nil
+}
+
+// isFixed returns true if value is a decimal formatted with exactly decimals
+// decimals, as StringFixed formats it. It checks amounts on every request
+// without parsing them as a decimal.
+func isFixed(value string, decimals int32) bool {
+	negative := strings.HasPrefix(value, "-")
+	value = strings.TrimPrefix(value, "-")
+
+	integer, fraction, dot := strings.Cut(value, ".")
+	if dot != (decimals > 0) || len(fraction) != int(decimals) {
+		return false
+	}
+	if integer == "" || len(integer) > 1 && integer[0] == '0' {
+		return false
+	}
+
+	zero := true
+	for _, digits := range []string{integer, fraction} {
+		for i := 0; i < len(digits); i++ {
+			if digits[i] < '0' || digits[i] > '9' {
+				return false
+			}
+			zero = zero && digits[i] == '0'
+		}
+	}
+
+	return !(negative && zero)
+}
+
+// MustAmount returns the Amount for value in currency, formatted with the
+// number of decimals for the currency. It panics if value is not a number.
+func MustAmount(currency string, value string) Amount {
+	a, err := NewAmount(currency, value)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	return a
+}
+
+// Decimal returns the amount value as a decimal.
+//
+// Deprecated: Decimal exposes the rollick/decimal fork and will be removed.
+// Use Minor for arithmetic, or Number for the exact value.
+func (a Amount) Decimal() (decimal.Decimal, error) {
+	return a.decimal()
+}
+
+// MustDecimal returns the amount value as a decimal. It panics if the value
+// is not a number.
+//
+// Deprecated: MustDecimal exposes the rollick/decimal fork and will be
+// removed. Use Minor for arithmetic, or Number for the exact value.
+func (a Amount) MustDecimal() decimal.Decimal {
+	return a.mustDecimal()
+}
+
+// decimal returns the amount value as a decimal
+func (a Amount) decimal() (decimal.Decimal, error) {
+	return decimal.NewFromString(a.Number)
+}
+
+// mustDecimal returns the amount value as a decimal. It panics if the value
+// is not a number.
+func (a Amount) mustDecimal() decimal.Decimal {
+	d, err := a.decimal()
+	if err != nil {
+		panic(fmt.Sprintf("invalid amount value %q: %v", a.Number, err))
+	}
+
+	return d
+}
+
+// Minor returns the amount value in minor units of the currency, eg. 1050
+// cents for EUR 10.50. It fails for values with more decimals than the
+// currency has.
+func (a Amount) Minor() (int64, error) {
+	d, err := a.decimal()
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount value %q: %v", a.Number, err)
+	}
+	minor := d.Mul(decimal.New(1, Decimals(a.Currency)))
+	if !minor.Equals(minor.Round(0)) {
+		return 0, fmt.Errorf("invalid amount value %q for %s, expected %d decimals", a.Number, a.Currency, Decimals(a.Currency))
+	}
+
+	return minor.IntPart(), nil
+}
+
+// Float64 returns the amount value as a float64
+func (a Amount) Float64() (float64, error) {
+	d, err := a.decimal()
+	if err != nil {
+		return 0, err
+	}
+	f, _ := d.Float64()
+
+	return f, nil
+}
+
+// ParseAmount parses an amount formatted by String, eg. "EUR 10.00"
+func ParseAmount(s string) (Amount, error) {
+	currency, value, ok := strings.Cut(strings.TrimSpace(s), " ")
+	if !ok {
+		return Amount{}, fmt.Errorf("invalid amount %q, expected currency and value", s)
+	}
+	a := Amount{Currency: currency, Number: value}
+	if err := a.Validate(); err != nil {
+		return Amount{}, err
+	}
+
+	return a, nil
+}
+
+// amountJSON is the JSON shape of an Amount
+type amountJSON struct {
+	Currency string `json:"currency"`
+	Number   string `json:"value"`
+}
+
+// MarshalJSON encodes the amount as a Mollie amount object, rather than the
+// text form of MarshalText
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(amountJSON(a))
+}
+
+// v1Currency is the currency of v1 API amounts, which were always in euro
+const v1Currency = "EUR"
+
+// UnmarshalJSON decodes a Mollie amount object. For stored v1 API payloads
+// it also accepts the v1 amount, a plain decimal string or number in euro.
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] != '{' && !bytes.Equal(data, []byte("null")) {
+		return a.unmarshalV1(data)
+	}
+
+	var v amountJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*a = Amount(v)
+
+	return nil
+}
+
+// unmarshalV1 decodes a v1 amount, eg. "10.00" or 10.5
+func (a *Amount) unmarshalV1(data []byte) error {
+	var value json.Number
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("invalid amount %s", data)
+	}
+	d, err := decimal.NewFromString(value.String())
+	if err != nil {
+		return fmt.Errorf("invalid amount %s: %v", data, err)
+	}
+	*a = newAmount(v1Currency, d)
+
+	return nil
+}
+
+// MarshalText encodes the amount as text, eg. "EUR 10.00"
+func (a Amount) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalText decodes an amount encoded by MarshalText
+func (a *Amount) UnmarshalText(text []byte) error {
+	amount, err := ParseAmount(string(text))
+	if err != nil {
+		return err
+	}
+	*a = amount
+
+	return nil
+}
+
+// Value stores the amount in a database text column in its String form, eg.
+// "EUR 10.00". The zero amount is stored as NULL.
+func (a Amount) Value() (driver.Value, error) {
+	if a == (Amount{}) {
+		return nil, nil
+	}
+
+	return a.String(), nil
+}
+
+// Scan reads an amount from a database text column holding the String form,
+// eg. "EUR 10.00", leaving the amount zero for NULL
+func (a *Amount) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*a = Amount{}
+		return nil
+	case string:
+		return a.UnmarshalText([]byte(v))
+	case []byte:
+		return a.UnmarshalText(v)
+	}
+
+	return fmt.Errorf("cannot scan %T into an Amount", src)
+}
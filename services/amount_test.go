@@ -0,0 +1,123 @@
+package services
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+)
+
+var (
+	_ driver.Valuer = Amount{}
+	_ sql.Scanner   = (*Amount)(nil)
+)
+
+func TestAmountSQL(t *testing.T) {
+	tests := []struct {
+		amount Amount
+		value  driver.Value
+	}{
+		{MustAmount("EUR", "10.00"), "EUR 10.00"},
+		{MustAmount("JPY", "1000"), "JPY 1000"},
+		{Amount{}, nil},
+	}
+
+	for _, tt := range tests {
+		value, err := tt.amount.Value()
+		if err != nil {
+			t.Errorf("%v: %v", tt.amount, err)
+			continue
+		}
+		if value != tt.value {
+			t.Errorf("%v stored as %v, want %v", tt.amount, value, tt.value)
+		}
+
+		var scanned Amount
+		if err := scanned.Scan(value); err != nil {
+			t.Errorf("scanning %v: %v", value, err)
+		}
+		if scanned != tt.amount {
+			t.Errorf("scanned %v, want %v", scanned, tt.amount)
+		}
+	}
+
+	var scanned Amount
+	if err := scanned.Scan([]byte("EUR 1.50")); err != nil || scanned != MustAmount("EUR", "1.50") {
+		t.Errorf("scanning bytes: got %v, %v", scanned, err)
+	}
+	for _, src := range []interface{}{"EUR", "EUR 1.5", 10} {
+		if err := scanned.Scan(src); err == nil {
+			t.Errorf("scanning %v: expected error", src)
+		}
+	}
+}
+
+func TestAmountDecimal(t *testing.T) {
+	amount := MustAmount("EUR", "10.50")
+	d, err := amount.Decimal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.StringFixed(2) != "10.50" || amount.MustDecimal().StringFixed(2) != "10.50" {
+		t.Errorf("got decimal %s, want 10.50", d)
+	}
+	if _, err := (Amount{Currency: "EUR", Number: "ten"}).Decimal(); err == nil {
+		t.Error("expected an error for a value that is not a number")
+	}
+}
+
+func TestAmountUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    Amount
+		wantErr bool
+	}{
+		{"v2", `{"currency":"EUR","value":"10.00"}`, Amount{"EUR", "10.00"}, false},
+		{"v2 without decimals", `{"currency":"JPY","value":"1000"}`, Amount{"JPY", "1000"}, false},
+		{"v2 kept as sent", `{"value":"10.5","currency":"USD"}`, Amount{"USD", "10.5"}, false},
+		{"v2 with spaces", ` { "currency" : "EUR", "value" : "1.00" } `, Amount{"EUR", "1.00"}, false},
+		{"null", `null`, Amount{}, false},
+		{"v1 string", `"10.00"`, Amount{"EUR", "10.00"}, false},
+		{"v1 string without decimals", `"10"`, Amount{"EUR", "10.00"}, false},
+		{"v1 string with one decimal", `"10.5"`, Amount{"EUR", "10.50"}, false},
+		{"v1 number", `10.5`, Amount{"EUR", "10.50"}, false},
+		{"v1 integer", `25`, Amount{"EUR", "25.00"}, false},
+		{"v1 negative", `"-5.00"`, Amount{"EUR", "-5.00"}, false},
+		{"v1 not a number", `"ten"`, Amount{}, true},
+		{"v1 empty string", `""`, Amount{}, true},
+		{"bool", `true`, Amount{}, true},
+		{"array", `["10.00"]`, Amount{}, true},
+		{"v2 invalid field", `{"currency":1,"value":"10.00"}`, Amount{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Amount
+			err := json.Unmarshal([]byte(tt.data), &got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("got %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAmountUnmarshalJSONFields(t *testing.T) {
+	var payment Payment
+	data := `{"amount":"10.00","amountRefunded":{"currency":"EUR","value":"2.50"},"settlementAmount":null}`
+	if err := json.Unmarshal([]byte(data), &payment); err != nil {
+		t.Fatal(err)
+	}
+	if payment.Amount != MustAmount("EUR", "10.00") {
+		t.Errorf("got amount %v", payment.Amount)
+	}
+	if payment.AmountRefunded == nil || *payment.AmountRefunded != MustAmount("EUR", "2.50") {
+		t.Errorf("got amount refunded %v", payment.AmountRefunded)
+	}
+	if payment.SettlementAmount != nil {
+		t.Errorf("got settlement amount %v, want nil", payment.SettlementAmount)
+	}
+}
@@ -0,0 +1,160 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/dghubble/sling"
+)
+
+// Balance transfer statuses
+// https://docs.mollie.com/reference/get-connect-balance-transfer
+const (
+	BalanceTransferStatusCreated   = "created"
+	BalanceTransferStatusFailed    = "failed"
+	BalanceTransferStatusSucceeded = "succeeded"
+)
+
+// BalanceTransferCategory is the reason for a balance transfer
+type BalanceTransferCategory string
+
+// Balance transfer categories
+const (
+	BalanceTransferCategoryInvoiceCollection    BalanceTransferCategory = "invoice_collection"
+	BalanceTransferCategoryPurchase             BalanceTransferCategory = "purchase"
+	BalanceTransferCategoryChargeback           BalanceTransferCategory = "chargeback"
+	BalanceTransferCategoryRefund               BalanceTransferCategory = "refund"
+	BalanceTransferCategoryServicePenalty       BalanceTransferCategory = "service_penalty"
+	BalanceTransferCategoryDiscountCompensation BalanceTransferCategory = "discount_compensation"
+	BalanceTransferCategoryManualCorrection     BalanceTransferCategory = "manual_correction"
+	BalanceTransferCategoryOtherFee             BalanceTransferCategory = "other_fee"
+)
+
+// BalanceTransferPartyType is the type of the source or destination of a
+// balance transfer
+type BalanceTransferPartyType string
+
+// Balance transfer party types
+const (
+	BalanceTransferPartyOrganization BalanceTransferPartyType = "organization"
+)
+
+// BalanceTransferParty is the source or destination of a balance transfer
+type BalanceTransferParty struct {
+	Type        BalanceTransferPartyType `json:"type" bson:"type"`
+	ID          string                   `json:"id" bson:"id"`
+	Description string                   `json:"description" bson:"description"`
+}
+
+// BalanceTransferStatusReason explains the status of a balance transfer
+type BalanceTransferStatusReason struct {
+	Code    string `json:"code" bson:"code"`
+	Message string `json:"message" bson:"message"`
+}
+
+// BalanceTransfer is a transfer between the balance of an organization and
+// a connected organization
+// https://docs.mollie.com/reference/get-connect-balance-transfer
+type BalanceTransfer struct {
+	Resource     string                       `json:"resource" bson:"resource"`
+	ID           string                       `json:"id" bson:"id"`
+	Mode         string                       `json:"mode" bson:"mode"`
+	Amount       Amount                       `json:"amount" bson:"amount"`
+	Source       BalanceTransferParty         `json:"source" bson:"source"`
+	Destination  BalanceTransferParty         `json:"destination" bson:"destination"`
+	Description  string                       `json:"description" bson:"description"`
+	Status       string                       `json:"status" bson:"status"`
+	StatusReason *BalanceTransferStatusReason `json:"statusReason" bson:"statusReason"`
+	Category     BalanceTransferCategory      `json:"category" bson:"category"`
+	CreatedAt    *time.Time                   `json:"createdAt" bson:"createdAt"`
+	ExecutedAt   *time.Time                   `json:"executedAt" bson:"executedAt"`
+	Links        BalanceTransferLinks         `json:"_links" bson:"_links"`
+}
+
+// BalanceTransferLinks represents the _links object returned in a BalanceTransfer
+type BalanceTransferLinks struct {
+	Self          Link `json:"self" bson:"self"`
+	Documentation Link `json:"documentation" bson:"documentation"`
+}
+
+// BalanceTransferRequest is a balance transfer create request
+// https://docs.mollie.com/reference/create-connect-balance-transfer
+type BalanceTransferRequest struct {
+	Amount      Amount                  `json:"amount" bson:"amount"`
+	Description string                  `json:"description" bson:"description"`
+	Source      BalanceTransferParty    `json:"source" bson:"source"`
+	Destination BalanceTransferParty    `json:"destination" bson:"destination"`
+	Category    BalanceTransferCategory `json:"category,omitempty" bson:"category,omitempty"`
+}
+
+// BalanceTransferList is a list of balance transfer objects and list metadata
+// https://docs.mollie.com/reference/list-connect-balance-transfers
+type BalanceTransferList = List[*BalanceTransfer]
+
+// BalanceTransferService provides methods for transferring balance to and
+// from connected organizations.
+type BalanceTransferService struct {
+	sling *sling.Sling
+}
+
+// NewBalanceTransferService returns a new BalanceTransferService.
+func NewBalanceTransferService(accessToken string, opts ...ClientOption) *BalanceTransferService {
+	// Create mollie api client
+	client := NewClient(accessToken, opts...)
+
+	return &BalanceTransferService{
+		sling: client,
+	}
+}
+
+// List returns the balance transfers
+func (s *BalanceTransferService) List(params *ListParams, opts ...RequestOption) (BalanceTransferList, *http.Response, error) {
+	return doGet[BalanceTransferList](s.sling, "connect/balance-transfers", params, opts...)
+}
+
+// Fetch returns a balance transfer
+func (s *BalanceTransferService) Fetch(transferId string, opts ...RequestOption) (BalanceTransfer, *http.Response, error) {
+	return doGet[BalanceTransfer](s.sling, fmt.Sprintf("connect/balance-transfers/%s", transferId), nil, opts...)
+}
+
+// Create transfers balance from the source to the destination organization
+func (s *BalanceTransferService) Create(transferBody *BalanceTransferRequest, opts ...RequestOption) (BalanceTransfer, *http.Response, error) {
+	if transferBody == nil {
+		return BalanceTransfer{}, nil, errRequiredBody
+	}
+	if err := transferBody.Amount.Validate(); err != nil {
+		return BalanceTransfer{}, nil, ValidationError{Field: "amount", Message: err.Error()}
+	}
+
+	return doPost[BalanceTransfer](s.sling, "connect/balance-transfers", transferBody, opts...)
+}
+
+// Iter returns an iterator over all balance transfers, starting at params
+func (s *BalanceTransferService) Iter(params *ListParams, opts ...RequestOption) *BalanceTransferIterator {
+	it := new(BalanceTransferIterator)
+	it.load = func(next string) (BalanceTransferList, error) {
+		if next == "" {
+			page, _, err := s.List(params, opts...)
+			return page, err
+		}
+		page, _, err := doGetNext[BalanceTransferList](s.sling, next, it.ctx, opts)
+		return page, err
+	}
+
+	return it
+}
+
+// All returns all balance transfers, following all pages up to MaxAllPages
+func (s *BalanceTransferService) All(ctx context.Context, params *ListParams, opts ...RequestOption) ([]*BalanceTransfer, error) {
+	it := s.Iter(params, contextOptions(ctx, opts)...)
+	it.limit(ctx, MaxAllPages)
+
+	var transfers []*BalanceTransfer
+	for it.Next() {
+		transfers = append(transfers, it.BalanceTransfer())
+	}
+
+	return transfers, it.Err()
+}
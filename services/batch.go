@@ -0,0 +1,137 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"sync"
+)
+
+// DefaultBatchWorkers is the number of concurrent requests of a batch when
+// no worker count is given
+const DefaultBatchWorkers = 10
+
+// BatchResult is the outcome of one item of a batch. Index is the position of
+// the item in the batch, and IdempotencyKey the key it was sent with, for
+// retrying failed items without creating them twice.
+type BatchResult[T any] struct {
+	Index          int
+	IdempotencyKey string
+	Value          T
+	Response       *http.Response
+	Err            error
+}
+
+// PaymentBatchItem is a payment to create in a batch. A random idempotency
+// key is used if none is set. Items without a request fail with a
+// ValidationError and are not sent.
+type PaymentBatchItem struct {
+	Request        *PaymentRequest
+	IdempotencyKey string
+}
+
+// RefundBatchItem is a payment refund to create in a batch. A random
+// idempotency key is used if none is set. Items without a request fail with
+// a ValidationError and are not sent.
+type RefundBatchItem struct {
+	PaymentID      string
+	Request        *PaymentRefundRequest
+	IdempotencyKey string
+}
+
+// CreateBatch creates payments concurrently with at most workers requests in
+// flight, returning a result per item in the order of items. Items not sent
+// before ctx is done fail with the context error.
+func (s *PaymentService) CreateBatch(ctx context.Context, items []PaymentBatchItem, workers int, opts ...RequestOption) []BatchResult[Payment] {
+	return runBatch(ctx, len(items), workers, func(i int) error {
+		if items[i].Request == nil {
+			return errRequiredBody
+		}
+		return nil
+	}, func(i int) string {
+		return items[i].IdempotencyKey
+	}, func(i int, opts []RequestOption) (Payment, *http.Response, error) {
+		return s.Create(items[i].Request, opts...)
+	}, opts)
+}
+
+// CreateRefundBatch creates payment refunds concurrently with at most workers
+// requests in flight, returning a result per item in the order of items.
+// Items not sent before ctx is done fail with the context error.
+func (s *PaymentService) CreateRefundBatch(ctx context.Context, items []RefundBatchItem, workers int, opts ...RequestOption) []BatchResult[PaymentRefund] {
+	return runBatch(ctx, len(items), workers, func(i int) error {
+		if items[i].Request == nil {
+			return errRequiredBody
+		}
+		return nil
+	}, func(i int) string {
+		return items[i].IdempotencyKey
+	}, func(i int, opts []RequestOption) (PaymentRefund, *http.Response, error) {
+		return s.CreateRefund(items[i].PaymentID, items[i].Request, opts...)
+	}, opts)
+}
+
+// runBatch runs create for n items on a pool of workers, sending each with
+// its idempotency key and ctx. Items are checked before any worker starts,
+// and those failing check are not sent.
+func runBatch[T any](ctx context.Context, n int, workers int, check func(int) error, key func(int) string, create func(int, []RequestOption) (T, *http.Response, error), opts []RequestOption) []BatchResult[T] {
+	if workers <= 0 {
+		workers = DefaultBatchWorkers
+	}
+	if workers > n {
+		workers = n
+	}
+
+	results := make([]BatchResult[T], n)
+	var pending []int
+	for i := range results {
+		results[i].Index = i
+		results[i].IdempotencyKey = key(i)
+		if results[i].Err = check(i); results[i].Err == nil {
+			pending = append(pending, i)
+		}
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				result := &results[i]
+				if result.IdempotencyKey == "" {
+					result.IdempotencyKey, result.Err = newIdempotencyKey()
+				}
+				if result.Err == nil {
+					result.Err = ctx.Err()
+				}
+				if result.Err != nil {
+					continue
+				}
+
+				itemOpts := append(contextOptions(ctx, opts), WithIdempotencyKey(result.IdempotencyKey))
+				result.Value, result.Response, result.Err = create(i, itemOpts)
+			}
+		}()
+	}
+
+	for _, i := range pending {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	return results
+}
+
+// newIdempotencyKey returns a random idempotency key
+func newIdempotencyKey() (string, error) {
+	key := make([]byte, 16)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(key), nil
+}
@@ -0,0 +1,86 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+)
+
+func TestCreateBatchNilRequests(t *testing.T) {
+	var calls atomic.Int32
+	payments := NewPaymentService("test_x", withTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if r.Header.Get("Idempotency-Key") == "" {
+			t.Error("request sent without an idempotency key")
+		}
+		writeJSON(w, http.StatusCreated, `{"id":"tr_1"}`)
+	}))
+
+	request := &PaymentRequest{
+		Amount:      MustAmount("EUR", "10.00"),
+		Description: "Order 1",
+		RedirectUrl: "https://example.com/return",
+	}
+	items := []PaymentBatchItem{
+		{Request: request},
+		{Request: nil, IdempotencyKey: "nil-1"},
+		{Request: request, IdempotencyKey: "key-2"},
+		{Request: nil},
+	}
+	results := payments.CreateBatch(context.Background(), items, 2)
+
+	tests := []struct {
+		key     string
+		invalid bool
+	}{
+		{"", false},
+		{"nil-1", true},
+		{"key-2", false},
+		{"", true},
+	}
+	for i, tt := range tests {
+		result := results[i]
+		if result.Index != i {
+			t.Errorf("result %d has index %d", i, result.Index)
+		}
+		if tt.key != "" && result.IdempotencyKey != tt.key {
+			t.Errorf("result %d has idempotency key %q, want %q", i, result.IdempotencyKey, tt.key)
+		}
+		var validation ValidationError
+		if invalid := errors.As(result.Err, &validation); invalid != tt.invalid {
+			t.Errorf("result %d: got error %v, want ValidationError %v", i, result.Err, tt.invalid)
+		}
+		if !tt.invalid && (result.Err != nil || result.Value.ID != "tr_1") {
+			t.Errorf("result %d: got %+v, %v", i, result.Value, result.Err)
+		}
+	}
+	if n := calls.Load(); n != 2 {
+		t.Errorf("%d requests sent, want 2", n)
+	}
+}
+
+func TestCreateRefundBatchNilRequests(t *testing.T) {
+	var calls atomic.Int32
+	payments := NewPaymentService("test_x", withTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		writeJSON(w, http.StatusCreated, `{"id":"re_1"}`)
+	}))
+
+	results := payments.CreateRefundBatch(context.Background(), []RefundBatchItem{
+		{PaymentID: "tr_1", Request: nil},
+		{PaymentID: "tr_2", Request: &PaymentRefundRequest{}},
+	}, 0)
+
+	var validation ValidationError
+	if !errors.As(results[0].Err, &validation) {
+		t.Errorf("nil request: got error %v, want a ValidationError", results[0].Err)
+	}
+	if results[1].Err != nil || results[1].Value.ID != "re_1" {
+		t.Errorf("got %+v, %v", results[1].Value, results[1].Err)
+	}
+	if n := calls.Load(); n != 1 {
+		t.Errorf("%d requests sent, want 1", n)
+	}
+}
@@ -0,0 +1,113 @@
+package services
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/dghubble/sling"
+)
+
+// ErrCircuitOpen is returned without calling Mollie while the circuit
+// breaker is open
+var ErrCircuitOpen = errors.New("circuit breaker open, Mollie requests are suspended")
+
+// circuitBreaker stops sending requests after a number of consecutive
+// failures, then lets a single probe request through after a cooldown to
+// check whether Mollie has recovered
+type circuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	mu       sync.Mutex
+	failures int
+	open     bool
+	openedAt time.Time
+	probing  bool
+}
+
+// WithCircuitBreaker opens the circuit after threshold consecutive 5xx
+// responses or transport errors (including client timeouts), failing requests
+// with ErrCircuitOpen. Requests canceled or timed out by their caller's
+// context are not counted. After cooldown a single probe request is let
+// through; the circuit closes again when it succeeds.
+func WithCircuitBreaker(threshold int, cooldown time.Duration) ClientOption {
+	b := &circuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+	}
+
+	return func(c *clientConfig) {
+		c.middleware = append(c.middleware, b.wrap)
+	}
+}
+
+// wrap returns a Doer sending requests through the circuit breaker
+func (b *circuitBreaker) wrap(next sling.Doer) sling.Doer {
+	return doerFunc(func(req *http.Request) (*http.Response, error) {
+		probe, err := b.allow()
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := next.Do(req)
+		if err != nil && req.Context().Err() != nil {
+			// The caller gave up, which says nothing about Mollie
+			b.release(probe)
+			return resp, err
+		}
+		b.record(probe, err != nil || resp.StatusCode >= 500)
+
+		return resp, err
+	})
+}
+
+// allow returns an error if the request may not be sent, and whether the
+// request is the half-open probe
+func (b *circuitBreaker) allow() (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.open {
+		return false, nil
+	}
+	if b.probing || time.Since(b.openedAt) < b.cooldown {
+		return false, ErrCircuitOpen
+	}
+	b.probing = true
+
+	return true, nil
+}
+
+// release ends a request without an outcome, letting another probe through
+// if it was the probe
+func (b *circuitBreaker) release(probe bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if probe {
+		b.probing = false
+	}
+}
+
+// record updates the breaker with the outcome of a request
+func (b *circuitBreaker) record(probe bool, failed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if probe {
+		b.probing = false
+	}
+	if !failed {
+		b.failures = 0
+		b.open = false
+		return
+	}
+
+	b.failures++
+	if probe || b.failures >= b.threshold {
+		b.open = true
+		b.openedAt = time.Now()
+	}
+}
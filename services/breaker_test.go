@@ -0,0 +1,98 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreakerContextErrors(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/payments/tr_slow":
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+		case "/v2/payments/tr_error":
+			writeJSON(w, http.StatusInternalServerError, `{"status":500,"title":"Internal Server Error"}`)
+		default:
+			writeJSON(w, http.StatusOK, `{"id":"tr_1"}`)
+		}
+	}
+
+	tests := []struct {
+		name  string
+		fetch func(s *PaymentService) error
+		opens bool
+	}{
+		{"caller canceled", func(s *PaymentService) error {
+			ctx, cancel := context.WithCancel(context.Background())
+			time.AfterFunc(20*time.Millisecond, cancel)
+			_, _, err := s.Fetch("tr_slow", WithContext(ctx))
+			return err
+		}, false},
+		{"caller deadline", func(s *PaymentService) error {
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancel()
+			_, _, err := s.Fetch("tr_slow", WithContext(ctx))
+			return err
+		}, false},
+		{"client timeout", func(s *PaymentService) error {
+			_, _, err := s.Fetch("tr_slow", WithRequestTimeout(20*time.Millisecond))
+			return err
+		}, true},
+		{"server error", func(s *PaymentService) error {
+			_, _, err := s.Fetch("tr_error")
+			return err
+		}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payments := NewPaymentService("test_x",
+				withTestServer(t, handler),
+				WithCircuitBreaker(1, time.Minute),
+			)
+
+			if err := tt.fetch(payments); err == nil {
+				t.Fatal("expected the first request to fail")
+			}
+
+			_, _, err := payments.Fetch("tr_1")
+			if opened := errors.Is(err, ErrCircuitOpen); opened != tt.opens {
+				t.Errorf("got %v after the failure, want circuit open %v", err, tt.opens)
+			}
+		})
+	}
+}
+
+func TestCircuitBreakerCanceledProbe(t *testing.T) {
+	payments := NewPaymentService("test_x",
+		withTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/v2/payments/tr_slow" {
+				<-r.Context().Done()
+				return
+			}
+			writeJSON(w, http.StatusInternalServerError, `{"status":500,"title":"Internal Server Error"}`)
+		}),
+		WithCircuitBreaker(1, 10*time.Millisecond),
+	)
+
+	if _, _, err := payments.Fetch("tr_error"); err == nil || errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("got %v, want a server error", err)
+	}
+	time.Sleep(20 * time.Millisecond)
+
+	// A canceled probe lets the next request probe again
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+	if _, _, err := payments.Fetch("tr_slow", WithContext(ctx)); errors.Is(err, ErrCircuitOpen) {
+		t.Fatal("probe was not let through")
+	}
+	if _, _, err := payments.Fetch("tr_error"); errors.Is(err, ErrCircuitOpen) {
+		t.Error("circuit stayed open after a canceled probe")
+	}
+}
@@ -0,0 +1,79 @@
+package services
+
+import (
+	"gopkg.in/mgo.v2/bson"
+)
+
+// Resources can be stored as returned by Mollie in MongoDB with mgo. Fields
+// carry bson tags matching their JSON names, so a stored document has the
+// same shape as the API response, and dates and intervals are stored in the
+// same string form as in JSON. Metadata and details are kept as the JSON sent
+// by Mollie and stored as binary. The response info of errors is not stored.
+//
+// Resources can also be cached as JSON: encoding a resource and decoding it
+// again gives the same resource, with absent links and application fees
+// encoded as null as Mollie does, and metadata and details byte for byte.
+
+// GetBSON stores the date as YYYY-MM-DD, or null for a nil or zero date. It
+// has a pointer receiver as mgo calls it on nil *Date fields.
+func (d *Date) GetBSON() (interface{}, error) {
+	if d == nil || d.IsZero() {
+		return nil, nil
+	}
+
+	return d.String(), nil
+}
+
+// SetBSON reads a YYYY-MM-DD date, leaving the date zero for null or an
+// empty string
+func (d *Date) SetBSON(raw bson.Raw) error {
+	var s string
+	if err := raw.Unmarshal(&s); err != nil {
+		return err
+	}
+	if s == "" {
+		*d = Date{}
+		return nil
+	}
+
+	date, err := ParseDate(s)
+	if err != nil {
+		return err
+	}
+	*d = date
+
+	return nil
+}
+
+// GetBSON stores the interval as a string, such as "1 month". The zero
+// interval is stored as an empty string.
+func (i Interval) GetBSON() (interface{}, error) {
+	if i == (Interval{}) {
+		return "", nil
+	}
+	if err := i.Validate(); err != nil {
+		return nil, err
+	}
+
+	return i.String(), nil
+}
+
+// SetBSON reads an interval string
+func (i *Interval) SetBSON(raw bson.Raw) error {
+	var s string
+	if err := raw.Unmarshal(&s); err != nil {
+		return err
+	}
+	if s == "" {
+		*i = Interval{}
+		return nil
+	}
+
+	interval, err := ParseInterval(s)
+	if err != nil {
+		return err
+	}
+	*i = interval
+
+	return nil
+}
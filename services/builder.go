@@ -0,0 +1,148 @@
+package services
+
+import "fmt"
+
+// issuerMethods are the methods accepting an issuer
+var issuerMethods = map[string]bool{
+	MethodIDEAL:    true,
+	MethodKBC:      true,
+	MethodGiftCard: true,
+}
+
+// PaymentBuilder builds a PaymentRequest, validating each value as it is
+// set. The first error is kept and returned by Build.
+type PaymentBuilder struct {
+	request PaymentRequest
+	err     error
+}
+
+// NewPayment returns a builder for a payment of amount
+func NewPayment(amount Amount) *PaymentBuilder {
+	b := &PaymentBuilder{request: PaymentRequest{Amount: amount}}
+	if err := amount.Validate(); err != nil {
+		b.fail("amount", err.Error())
+	}
+
+	return b
+}
+
+// Description sets the description shown to the customer
+func (b *PaymentBuilder) Description(description string) *PaymentBuilder {
+	if description == "" {
+		b.fail("description", "is required")
+	}
+	b.request.Description = description
+	return b
+}
+
+// Redirect sets the URL the customer is redirected to after the checkout
+func (b *PaymentBuilder) Redirect(redirectUrl string) *PaymentBuilder {
+	if err := validateURL(redirectUrl); err != nil {
+		b.fail("redirectUrl", err.Error())
+	}
+	b.request.RedirectUrl = redirectUrl
+	return b
+}
+
+// Cancel sets the URL the customer is redirected to when canceling the checkout
+func (b *PaymentBuilder) Cancel(cancelUrl string) *PaymentBuilder {
+	if err := validateURL(cancelUrl); err != nil {
+		b.fail("cancelUrl", err.Error())
+	}
+	b.request.CancelUrl = cancelUrl
+	return b
+}
+
+// Webhook sets the URL Mollie calls when the payment status changes
+func (b *PaymentBuilder) Webhook(webhookUrl string) *PaymentBuilder {
+	if err := validateURL(webhookUrl); err != nil {
+		b.fail("webhookUrl", err.Error())
+	}
+	b.request.WebhookUrl = webhookUrl
+	return b
+}
+
+// Method sets the payment method, skipping the method selection
+func (b *PaymentBuilder) Method(method string) *PaymentBuilder {
+	b.request.Method = method
+	if b.request.Issuer != "" && !issuerMethods[method] {
+		b.fail("issuer", fmt.Sprintf("is not supported by method %q", method))
+	}
+	return b
+}
+
+// Issuer sets the issuer of an iDEAL, KBC or gift card payment. The method
+// must be set first.
+func (b *PaymentBuilder) Issuer(issuer string) *PaymentBuilder {
+	if !issuerMethods[b.request.Method] {
+		b.fail("issuer", fmt.Sprintf("is not supported by method %q", b.request.Method))
+	}
+	b.request.Issuer = issuer
+	return b
+}
+
+// Locale sets the locale of the checkout
+func (b *PaymentBuilder) Locale(locale string) *PaymentBuilder {
+	b.request.Locale = locale
+	return b
+}
+
+// Metadata sets the metadata of the payment
+func (b *PaymentBuilder) Metadata(v interface{}) *PaymentBuilder {
+	if err := b.request.SetMetadata(v); err != nil {
+		b.fail("metadata", err.Error())
+	}
+	return b
+}
+
+// Line adds a payment line
+func (b *PaymentBuilder) Line(line PaymentLine) *PaymentBuilder {
+	if err := line.Validate(); err != nil {
+		if v, ok := err.(ValidationError); ok {
+			b.fail(fmt.Sprintf("lines[%d].%s", len(b.request.Lines), v.Field), v.Message)
+		}
+	}
+	b.request.Lines = append(b.request.Lines, line)
+	return b
+}
+
+// First makes the payment the first payment of a customer, creating a
+// mandate for recurring payments
+func (b *PaymentBuilder) First(customerId string) *PaymentBuilder {
+	b.request.CustomerID = customerId
+	b.request.SequenceType = SequenceTypeFirst
+	return b
+}
+
+// Recurring makes the payment a recurring payment on a customer mandate. The
+// mandate may be "" to use any valid mandate of the customer.
+func (b *PaymentBuilder) Recurring(customerId string, mandateId string) *PaymentBuilder {
+	if customerId == "" {
+		b.fail("customerId", "is required for recurring payments")
+	}
+	b.request.CustomerID = customerId
+	b.request.MandateID = mandateId
+	b.request.SequenceType = SequenceTypeRecurring
+	return b
+}
+
+// Build returns the payment request, or the first error found while building
+// or validating it. Validation does not know the client PaymentDefaults, so
+// the redirect URL must be set on the builder.
+func (b *PaymentBuilder) Build() (PaymentRequest, error) {
+	if b.err != nil {
+		return PaymentRequest{}, b.err
+	}
+	if err := b.request.Validate(); err != nil {
+		return PaymentRequest{}, err
+	}
+
+	return b.request, nil
+}
+
+// fail records the first validation error
+func (b *PaymentBuilder) fail(field string, message string) {
+	if b.err == nil {
+		b.err = ValidationError{Field: field, Message: message}
+	}
+}
@@ -0,0 +1,41 @@
+package services
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Capture statuses
+// https://docs.mollie.com/reference/v2/captures-api/get-capture#response
+const (
+	CaptureStatusPending   = "pending"
+	CaptureStatusSucceeded = "succeeded"
+	CaptureStatusFailed    = "failed"
+)
+
+// Capture is a capture of an authorized payment
+// https://docs.mollie.com/reference/v2/captures-api/get-capture#response
+type Capture struct {
+	Resource         string          `json:"resource" bson:"resource"`
+	ID               string          `json:"id" bson:"id"`
+	Mode             string          `json:"mode" bson:"mode"`
+	Description      string          `json:"description" bson:"description"`
+	Amount           Amount          `json:"amount" bson:"amount"`
+	SettlementAmount *Amount         `json:"settlementAmount" bson:"settlementAmount"`
+	Status           string          `json:"status" bson:"status"`
+	Metadata         json.RawMessage `json:"metadata" bson:"metadata"`
+	PaymentID        string          `json:"paymentId" bson:"paymentId"`
+	ShipmentID       string          `json:"shipmentId" bson:"shipmentId"`
+	SettlementID     string          `json:"settlementId" bson:"settlementId"`
+	CreatedAt        *time.Time      `json:"createdAt" bson:"createdAt"`
+	Links            CaptureLinks    `json:"_links" bson:"_links"`
+}
+
+// CaptureLinks represents the _links object returned in a Capture
+type CaptureLinks struct {
+	Self          Link `json:"self" bson:"self"`
+	Payment       Link `json:"payment" bson:"payment"`
+	Shipment      Link `json:"shipment" bson:"shipment"`
+	Settlement    Link `json:"settlement" bson:"settlement"`
+	Documentation Link `json:"documentation" bson:"documentation"`
+}
@@ -0,0 +1,40 @@
+package services
+
+import (
+	"time"
+)
+
+// Chargeback is a payment chargeback object
+// https://docs.mollie.com/reference/v2/chargebacks-api/get-chargeback#response
+type Chargeback struct {
+	Resource         string            `json:"resource" bson:"resource"`
+	ID               string            `json:"id" bson:"id"`
+	Amount           Amount            `json:"amount" bson:"amount"`
+	SettlementAmount *Amount           `json:"settlementAmount" bson:"settlementAmount"`
+	Reason           *ChargebackReason `json:"reason" bson:"reason"`
+	PaymentID        string            `json:"paymentId" bson:"paymentId"`
+	CreatedAt        *time.Time        `json:"createdAt" bson:"createdAt"`
+	ReversedAt       *time.Time        `json:"reversedAt" bson:"reversedAt"`
+	Links            ChargebackLinks   `json:"_links" bson:"_links"`
+}
+
+// ChargebackReason is the reason given by the bank for a chargeback
+// https://docs.mollie.com/reference/v2/chargebacks-api/get-chargeback#response
+type ChargebackReason struct {
+	Code        string `json:"code" bson:"code"`
+	Description string `json:"description" bson:"description"`
+}
+
+// ChargebackLinks represents the _links object returned in a Chargeback
+// https://docs.mollie.com/reference/v2/chargebacks-api/get-chargeback#response
+type ChargebackLinks struct {
+	Self          Link `json:"self" bson:"self"`
+	Payment       Link `json:"payment" bson:"payment"`
+	Settlement    Link `json:"settlement" bson:"settlement"`
+	Documentation Link `json:"documentation" bson:"documentation"`
+}
+
+// IsReversed returns true if the chargeback has been reversed
+func (c Chargeback) IsReversed() bool {
+	return c.ReversedAt != nil
+}
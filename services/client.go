@@ -0,0 +1,165 @@
+package services
+
+import (
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/dghubble/sling"
+)
+
+// ClientOption configures the Mollie client used by a service. Options that
+// hold state, such as a circuit breaker, share it between all services they
+// are passed to. So do the transport options: services created with the same
+// options but different access tokens, eg. a live key for payments and a test
+// key for a staging profile, share one connection pool.
+type ClientOption func(*clientConfig)
+
+// clientConfig is the configuration of a Mollie client
+type clientConfig struct {
+	transport http.RoundTripper
+	timeout   time.Duration
+	userAgent string
+
+	methodCacheTTL  time.Duration
+	paymentDefaults PaymentDefaults
+	middleware      []func(sling.Doer) sling.Doer
+}
+
+// newClientConfig returns the client configuration set by opts
+func newClientConfig(opts []ClientOption) *clientConfig {
+	c := &clientConfig{
+		timeout: DefaultTimeout,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// doer returns the Doer sending requests through the configured middleware
+func (c *clientConfig) doer() sling.Doer {
+	doer := withTimeout(withAPICredentials(withTransportErrors(&http.Client{Transport: c.transport})), c.timeout)
+	for _, middleware := range c.middleware {
+		doer = middleware(doer)
+	}
+
+	return doer
+}
+
+// WithTransport sends requests with transport, eg. for a corporate proxy,
+// mTLS or custom DNS resolution. The default is http.DefaultTransport.
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return func(c *clientConfig) {
+		c.transport = transport
+	}
+}
+
+// WithProxy sends requests through the proxy at proxyURL
+func WithProxy(proxyURL *url.URL) ClientOption {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = http.ProxyURL(proxyURL)
+
+	return WithTransport(transport)
+}
+
+// PoolConfig is the connection pool configuration of the transport. Zero
+// values keep the http.DefaultTransport settings.
+type PoolConfig struct {
+	MaxIdleConns        int
+	MaxIdleConnsPerHost int
+	MaxConnsPerHost     int
+	IdleConnTimeout     time.Duration
+	KeepAlive           time.Duration
+}
+
+// WithConnectionPool sends requests with a transport using the connection
+// pool configuration. It replaces the transport set by WithTransport or
+// WithProxy; to combine them, configure an http.Transport with WithTransport.
+func WithConnectionPool(pool PoolConfig) ClientOption {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if pool.MaxIdleConns > 0 {
+		transport.MaxIdleConns = pool.MaxIdleConns
+	}
+	if pool.MaxIdleConnsPerHost > 0 {
+		transport.MaxIdleConnsPerHost = pool.MaxIdleConnsPerHost
+	}
+	if pool.MaxConnsPerHost > 0 {
+		transport.MaxConnsPerHost = pool.MaxConnsPerHost
+	}
+	if pool.IdleConnTimeout > 0 {
+		transport.IdleConnTimeout = pool.IdleConnTimeout
+	}
+	if pool.KeepAlive != 0 {
+		dialer := &net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: pool.KeepAlive,
+		}
+		transport.DialContext = dialer.DialContext
+	}
+
+	return WithTransport(transport)
+}
+
+// PaymentDefaults are the values used for payment requests that leave them
+// unset
+type PaymentDefaults struct {
+	Locale      string
+	RedirectUrl string
+	WebhookUrl  string
+}
+
+// WithPaymentDefaults sets the locale, redirect and webhook URL of payment
+// requests that leave them unset. The redirect URL is not set on recurring
+// payments, which have no checkout.
+func WithPaymentDefaults(defaults PaymentDefaults) ClientOption {
+	return func(c *clientConfig) {
+		c.paymentDefaults = defaults
+	}
+}
+
+// apply returns r with the defaults set for its unset fields
+func (d PaymentDefaults) apply(r PaymentRequest) PaymentRequest {
+	if r.Locale == "" {
+		r.Locale = d.Locale
+	}
+	if r.RedirectUrl == "" && r.SequenceType != SequenceTypeRecurring {
+		r.RedirectUrl = d.RedirectUrl
+	}
+	if r.WebhookUrl == "" {
+		r.WebhookUrl = d.WebhookUrl
+	}
+
+	return r
+}
+
+// isAPIRequest returns true if req is sent to the Mollie API rather than to
+// another host, such as the CDN serving method icons
+func isAPIRequest(req *http.Request) bool {
+	return strings.EqualFold(req.URL.Scheme, "https") && strings.EqualFold(req.URL.Host, strings.TrimPrefix(baseURL, "https://"))
+}
+
+// withAPICredentials returns a Doer removing the Authorization header from
+// requests that are not sent to the Mollie API, so the access token of a
+// followed link never reaches another host
+func withAPICredentials(next sling.Doer) sling.Doer {
+	return doerFunc(func(req *http.Request) (*http.Response, error) {
+		if !isAPIRequest(req) && req.Header.Get("Authorization") != "" {
+			req = req.Clone(req.Context())
+			req.Header.Del("Authorization")
+		}
+
+		return next.Do(req)
+	})
+}
+
+// doerFunc is a function implementing sling.Doer
+type doerFunc func(req *http.Request) (*http.Response, error)
+
+// Do sends the request
+func (f doerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
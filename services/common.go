@@ -1,61 +1,182 @@
 package services
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/dghubble/sling"
 )
 
 const (
-	baseURL    = "https://api.mollie.nl"
-	apiVersion = "v1"
+	baseURL    = "https://api.mollie.com"
+	apiVersion = "v2"
 )
 
 // MollieError represents a Mollie API error response
+// https://docs.mollie.com/overview/handling-errors
 type MollieError struct {
-	Err struct {
-		Type    string `json:"type"`
-		Message string `json:"message"`
-		Field   string `json:"field"`
-	} `json:"error"`
+	Status int              `json:"status" bson:"status"`
+	Title  string           `json:"title" bson:"title"`
+	Detail string           `json:"detail" bson:"detail"`
+	Field  string           `json:"field,omitempty" bson:"field,omitempty"`
+	Links  MollieErrorLinks `json:"_links" bson:"_links"`
+
+	// Response is the metadata of the error response
+	Response ResponseInfo `json:"-" bson:"-"`
+}
+
+// MollieErrorLinks represents the _links object returned in an error response
+type MollieErrorLinks struct {
+	Documentation Link `json:"documentation" bson:"documentation"`
 }
 
+// SortOrder is the order of a list, by creation date
+type SortOrder string
+
+// List sort orders
+const (
+	SortAsc  SortOrder = "asc"
+	SortDesc SortOrder = "desc"
+)
+
 // ListParams are the params for any list request
-// https://www.mollie.com/nl/docs/reference/payments/list#parameters
+// https://docs.mollie.com/guides/pagination
 type ListParams struct {
-	Offset int `url:"offset,omitempty"`
-	Count  int `url:"count,omitempty"`
+	From string `url:"from,omitempty"`
+	// Limit is the number of items per page, up to MaxListLimit. Zero leaves
+	// it out, so Mollie uses its default.
+	Limit int       `url:"limit,omitempty"`
+	Sort  SortOrder `url:"sort,omitempty"`
+}
+
+// MaxListLimit is the maximum number of items Mollie returns per list page
+const MaxListLimit = 250
+
+// Validate checks the limit is within what Mollie accepts, or zero for the
+// default
+func (p ListParams) Validate() error {
+	if p.Limit < 0 || p.Limit > MaxListLimit {
+		return ValidationError{Field: "limit", Message: fmt.Sprintf("must be between 1 and %d, or 0 for the default", MaxListLimit)}
+	}
+
+	return nil
 }
 
 // ListLinks is a standard list links object for a resource list query
 type ListLinks struct {
-	Previous string `json:"previous"`
-	Next     string `json:"next"`
-	First    string `json:"first"`
-	Last     string `json:"last"`
+	Self          Link `json:"self" bson:"self"`
+	Previous      Link `json:"previous" bson:"previous"`
+	Next          Link `json:"next" bson:"next"`
+	Documentation Link `json:"documentation" bson:"documentation"`
 }
 
 // ListMetadata is basic metadata for list queries
 type ListMetadata struct {
-	TotalCount int       `json:"totalCount"`
-	Offset     int       `json:"offset"`
-	Count      int       `json:"count"`
-	Links      ListLinks `json:"links"`
+	Count int       `json:"count" bson:"count"`
+	Links ListLinks `json:"_links" bson:"_links"`
+}
+
+// HasNext returns true if there is a next page
+func (m ListMetadata) HasNext() bool {
+	return m.Links.Next.Href != ""
+}
+
+// HasPrevious returns true if there is a previous page
+func (m ListMetadata) HasPrevious() bool {
+	return m.Links.Previous.Href != ""
+}
+
+// NextFrom returns the from param of the next page, or "" if there is none
+func (m ListMetadata) NextFrom() string {
+	return CursorOf(m.Links.Next).From
 }
 
-// NewClient returns a new Mollie client
-func NewClient(accessToken string) *sling.Sling {
+// PreviousFrom returns the from param of the previous page, or "" if there
+// is none
+func (m ListMetadata) PreviousFrom() string {
+	return CursorOf(m.Links.Previous).From
+}
+
+// List is a page of resources and the list metadata. The resources are
+// returned by Mollie in the _embedded object, keyed by resource name.
+type List[T any] struct {
+	Items        []T `json:"-" bson:"items"`
+	ListMetadata `bson:",inline"`
+	embedded     string
+}
+
+// listJSON is the list shape sent by Mollie
+type listJSON struct {
+	Embedded map[string]json.RawMessage `json:"_embedded"`
+	ListMetadata
+}
+
+// UnmarshalJSON decodes a list page, taking the items from the _embedded object
+func (l *List[T]) UnmarshalJSON(data []byte) error {
+	var page listJSON
+	if err := json.Unmarshal(data, &page); err != nil {
+		return err
+	}
+
+	l.ListMetadata = page.ListMetadata
+	l.Items = nil
+	for key, items := range page.Embedded {
+		l.embedded = key
+		if err := json.Unmarshal(items, &l.Items); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalJSON encodes a list page in the shape sent by Mollie
+func (l List[T]) MarshalJSON() ([]byte, error) {
+	key := l.embedded
+	if key == "" {
+		key = "items"
+	}
+	items, err := json.Marshal(l.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(listJSON{
+		Embedded:     map[string]json.RawMessage{key: items},
+		ListMetadata: l.ListMetadata,
+	})
+}
+
+// NewClient returns a new Mollie client. The client is a template that is
+// never modified after creation; requests are built on a copy from New, so
+// the client can be shared by concurrent requests.
+func NewClient(accessToken string, opts ...ClientOption) *sling.Sling {
+	config := newClientConfig(opts)
+
+	return newClient(accessToken, config, config.doer())
+}
+
+// newClient returns a new Mollie client sending requests with doer
+func newClient(accessToken string, config *clientConfig, doer sling.Doer) *sling.Sling {
 	// Create mollie api client
-	client := sling.New().Client(nil).Base(fmt.Sprintf("%s/%s/", baseURL, apiVersion))
+	client := sling.New().Doer(doer).Base(fmt.Sprintf("%s/%s/", baseURL, apiVersion))
 
 	// Add request headers
 	client.Set("authorization", fmt.Sprintf("Bearer %s", accessToken))
-	client.Set("user-agent", "Mollie/1.1.8 Go/1.4 OpenSSL/1.0.2d")
+	client.Set("user-agent", userAgent(config.userAgent))
 
 	return client
 }
 
 // Error is a formatted Mollie error
 func (e MollieError) Error() string {
-	return fmt.Sprintf("Mollie %v error: %v %v", e.Err.Type, e.Err.Message, e.Err.Field)
+	msg := fmt.Sprintf("Mollie %d %s: %s", e.Status, e.Title, e.Detail)
+	if e.Field != "" {
+		msg = fmt.Sprintf("%s (field %s)", msg, e.Field)
+	}
+	if e.Response.RequestID != "" {
+		msg = fmt.Sprintf("%s (request %s)", msg, e.Response.RequestID)
+	}
+
+	return msg
 }
@@ -0,0 +1,30 @@
+package services
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestListParamsValidate(t *testing.T) {
+	tests := []struct {
+		limit int
+		valid bool
+	}{
+		{0, true},
+		{1, true},
+		{MaxListLimit, true},
+		{-1, false},
+		{MaxListLimit + 1, false},
+	}
+
+	for _, tt := range tests {
+		err := ListParams{Limit: tt.limit}.Validate()
+		if valid := err == nil; valid != tt.valid {
+			t.Errorf("limit %d: got error %v, want valid %v", tt.limit, err, tt.valid)
+		}
+		var validation ValidationError
+		if err != nil && (!errors.As(err, &validation) || validation.Field != "limit") {
+			t.Errorf("limit %d: got %v, want a limit ValidationError", tt.limit, err)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package services
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// Run with go test -race: these tests share clients between goroutines to
+// catch data races in the shared client state.
+
+const concurrency = 16
+
+// parallel runs fn concurrently in n goroutines and waits for them
+func parallel(n int, fn func(i int)) {
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			fn(i)
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestConcurrentServiceCalls(t *testing.T) {
+	opts := []ClientOption{
+		withTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.URL.Path == "/v2/methods":
+				writeJSON(w, http.StatusOK, `{"count":1,"_embedded":{"methods":[{"id":"ideal"}]}}`)
+			case r.URL.Path == "/v2/payments" && r.Method == http.MethodGet:
+				writeJSON(w, http.StatusOK, `{"count":1,"_embedded":{"payments":[{"id":"tr_1"}]}}`)
+			case strings.HasPrefix(r.URL.Path, "/v2/payments"):
+				writeJSON(w, http.StatusOK, `{"id":"tr_1","status":"open"}`)
+			default:
+				writeJSON(w, http.StatusOK, `{"id":"cst_1"}`)
+			}
+		}),
+		WithCircuitBreaker(3, time.Minute),
+		WithMethodCache(time.Minute),
+		WithPaymentDefaults(PaymentDefaults{RedirectUrl: "https://example.com/return"}),
+	}
+	payments := NewPaymentService("test_x", opts...)
+	customers := NewCustomerService("test_x", opts...)
+	methods := NewMethodService("test_x", opts...)
+
+	request := &PaymentRequest{Amount: MustAmount("EUR", "10.00"), Description: "Order 1"}
+	parallel(concurrency, func(i int) {
+		for j := 0; j < 10; j++ {
+			var err error
+			switch (i + j) % 5 {
+			case 0:
+				_, _, err = payments.Fetch("tr_1", WithTestmode())
+			case 1:
+				_, _, err = payments.Create(request, WithIdempotencyKey("key"))
+			case 2:
+				_, _, err = payments.List(&ListParams{Limit: 1})
+			case 3:
+				_, _, err = methods.List(&MethodListParams{})
+			case 4:
+				_, _, err = customers.Fetch("cst_1")
+			}
+			if err != nil {
+				t.Error(err)
+			}
+		}
+	})
+}
+
+func TestConcurrentCircuitBreaker(t *testing.T) {
+	var calls atomic.Int32
+	opts := []ClientOption{
+		withTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			writeJSON(w, http.StatusInternalServerError, `{"status":500,"title":"Internal Server Error"}`)
+		}),
+		WithCircuitBreaker(5, time.Minute),
+	}
+	payments := NewPaymentService("test_x", opts...)
+	customers := NewCustomerService("test_x", opts...)
+
+	var open atomic.Int32
+	parallel(concurrency, func(i int) {
+		for j := 0; j < 10; j++ {
+			var err error
+			if i%2 == 0 {
+				_, _, err = payments.Fetch("tr_1")
+			} else {
+				_, _, err = customers.Fetch("cst_1")
+			}
+			if errors.Is(err, ErrCircuitOpen) {
+				open.Add(1)
+			}
+		}
+	})
+
+	if open.Load() == 0 {
+		t.Error("circuit never opened")
+	}
+	if n := calls.Load(); n >= concurrency*10 {
+		t.Errorf("all %d requests reached the server through an open circuit", n)
+	}
+}
+
+func TestConcurrentMethodCache(t *testing.T) {
+	var calls atomic.Int32
+	methods := NewMethodService("test_x",
+		withTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			writeJSON(w, http.StatusOK, `{"count":1,"_embedded":{"methods":[{"id":"ideal"}]}}`)
+		}),
+		WithMethodCache(time.Minute),
+	)
+
+	parallel(concurrency, func(i int) {
+		params := &MethodListParams{Locale: [2]string{"nl_NL", "en_US"}[i%2]}
+		for j := 0; j < 10; j++ {
+			list, _, err := methods.List(params)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if len(list.Items) != 1 || list.Items[0].ID != "ideal" {
+				t.Errorf("got methods %v", list.Items)
+			}
+		}
+	})
+
+	// Each locale is fetched at least once, and concurrent misses at most
+	// once per goroutine
+	if n := calls.Load(); n < 2 || n > concurrency {
+		t.Errorf("%d method requests, want between 2 and %d", n, concurrency)
+	}
+}
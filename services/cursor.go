@@ -0,0 +1,97 @@
+package services
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// Cursor is the position of a list page, taken from a page link. It can be
+// persisted as text, so a long running export can resume after a crash by
+// passing it to ListParams.Resume.
+type Cursor struct {
+	From  string
+	Limit int
+}
+
+// CursorOf returns the cursor of the list page link points to, or the zero
+// Cursor if link is empty
+func CursorOf(link Link) Cursor {
+	if link.Href == "" {
+		return Cursor{}
+	}
+	u, err := url.Parse(link.Href)
+	if err != nil {
+		return Cursor{}
+	}
+
+	query := u.Query()
+	limit, _ := strconv.Atoi(query.Get("limit"))
+
+	return Cursor{From: query.Get("from"), Limit: limit}
+}
+
+// ParseCursor parses a cursor persisted with String
+func ParseCursor(s string) (Cursor, error) {
+	query, err := url.ParseQuery(s)
+	if err != nil {
+		return Cursor{}, err
+	}
+
+	var c Cursor
+	c.From = query.Get("from")
+	if limit := query.Get("limit"); limit != "" {
+		if c.Limit, err = strconv.Atoi(limit); err != nil {
+			return Cursor{}, err
+		}
+	}
+
+	return c, nil
+}
+
+// IsZero returns true for the cursor of the first page
+func (c Cursor) IsZero() bool {
+	return c.From == ""
+}
+
+// String returns the cursor as a query string
+func (c Cursor) String() string {
+	query := url.Values{}
+	if c.From != "" {
+		query.Set("from", c.From)
+	}
+	if c.Limit > 0 {
+		query.Set("limit", strconv.Itoa(c.Limit))
+	}
+
+	return query.Encode()
+}
+
+// MarshalText encodes the cursor as a query string
+func (c Cursor) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText decodes a cursor encoded with MarshalText
+func (c *Cursor) UnmarshalText(text []byte) error {
+	cursor, err := ParseCursor(string(text))
+	if err != nil {
+		return err
+	}
+	*c = cursor
+
+	return nil
+}
+
+// Resume makes the list start at the page of cursor
+func (p *ListParams) Resume(cursor Cursor) {
+	p.From = cursor.From
+	if cursor.Limit > 0 {
+		p.Limit = cursor.Limit
+	}
+}
+
+// NextCursor returns the cursor of the next page, or the zero Cursor if
+// there is none
+func (m ListMetadata) NextCursor() Cursor {
+	return CursorOf(m.Links.Next)
+}
@@ -1,6 +1,9 @@
 package services
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -9,115 +12,179 @@ import (
 )
 
 // CustomerList is a list of customer objects and list metadata
-// https://www.mollie.com/nl/docs/reference/customers/list#response
-type CustomerList struct {
-	Data         []*Customer `json:"data"`
-	ListMetadata `bson:",inline"`
-}
+// https://docs.mollie.com/reference/v2/customers-api/list-customers#response
+type CustomerList = List[*Customer]
 
 // Customer is a customer object
-// https://www.mollie.com/nl/docs/reference/customers/get#response
+// https://docs.mollie.com/reference/v2/customers-api/get-customer#response
 type Customer struct {
-	Resource  string    `json:"resource"`
-	ID        string    `json:"id"`
-	Mode      string    `json:"mode"`
-	Name      string    `json:"name"`
-	Email     string    `json:"email"`
-	Locale    string    `json:"locale"`
-	Metadata  string    `json:"metadata"`
-	Methods   []string  `json:"recentlyUsedMethods"`
-	CreatedAt time.Time `json:"createdDatetime"`
+	Resource  string          `json:"resource" bson:"resource"`
+	ID        string          `json:"id" bson:"id"`
+	Mode      string          `json:"mode" bson:"mode"`
+	Name      string          `json:"name" bson:"name"`
+	Email     string          `json:"email" bson:"email"`
+	Locale    string          `json:"locale" bson:"locale"`
+	Metadata  json.RawMessage `json:"metadata" bson:"metadata"`
+	CreatedAt *time.Time      `json:"createdAt" bson:"createdAt"`
+	Links     CustomerLinks   `json:"_links" bson:"_links"`
+}
+
+// CustomerLinks represents the _links object returned in a Customer
+// https://docs.mollie.com/reference/v2/customers-api/get-customer#response
+type CustomerLinks struct {
+	Self          Link `json:"self" bson:"self"`
+	Dashboard     Link `json:"dashboard" bson:"dashboard"`
+	Mandates      Link `json:"mandates" bson:"mandates"`
+	Subscriptions Link `json:"subscriptions" bson:"subscriptions"`
+	Payments      Link `json:"payments" bson:"payments"`
+	Documentation Link `json:"documentation" bson:"documentation"`
+}
+
+// DashboardURL returns the URL of the customer in the Mollie dashboard
+func (c Customer) DashboardURL() string {
+	return c.Links.Dashboard.Href
 }
 
+// ErrNoValidMandate is returned when a customer has no usable mandate
+var ErrNoValidMandate = errors.New("customer has no valid mandate")
+
 // CustomerRequest is a customer create request
 // https://www.mollie.com/nl/docs/reference/customers/create#parameters
 type CustomerRequest struct {
-	Name     string      `json:"name,omitempty"`
-	Email    string      `json:"email,omitempty"`
-	Locale   string      `json:"locale,omitempty"`
-	Metadata interface{} `json:"metadata,omitempty"`
+	Name     string          `json:"name,omitempty" bson:"name,omitempty"`
+	Email    string          `json:"email,omitempty" bson:"email,omitempty"`
+	Locale   string          `json:"locale,omitempty" bson:"locale,omitempty"`
+	Metadata json.RawMessage `json:"metadata,omitempty" bson:"metadata,omitempty"`
 }
 
 // CustomerService provides methods for accessing customer records.
 type CustomerService struct {
-	sling *sling.Sling
+	sling           *sling.Sling
+	paymentDefaults PaymentDefaults
 }
 
 // NewCustomerService returns a new CustomerService.
-func NewCustomerService(accessToken string) *CustomerService {
+func NewCustomerService(accessToken string, opts ...ClientOption) *CustomerService {
 	// Create mollie api client
-	client := NewClient(accessToken)
+	client := NewClient(accessToken, opts...)
 
 	return &CustomerService{
-		sling: client,
+		sling:           client,
+		paymentDefaults: newClientConfig(opts).paymentDefaults,
 	}
 }
 
 // List returns all customers created.
-func (s *CustomerService) List(params *ListParams) (CustomerList, *http.Response, error) {
-	customers := new(CustomerList)
-	mollieError := new(MollieError)
-	resp, err := s.sling.New().Path("customers").QueryStruct(params).Receive(customers, mollieError)
-	if err == nil && mollieError.Err.Type != "" {
-		err = mollieError
+func (s *CustomerService) List(params *ListParams, opts ...RequestOption) (CustomerList, *http.Response, error) {
+	return doGet[CustomerList](s.sling, "customers", params, opts...)
+}
+
+// Fetch returns a created customer
+func (s *CustomerService) Fetch(customerId string, opts ...RequestOption) (Customer, *http.Response, error) {
+	return doGet[Customer](s.sling, fmt.Sprintf("customers/%s", customerId), nil, opts...)
+}
+
+// Create creates a new customer
+func (s *CustomerService) Create(customerBody *CustomerRequest, opts ...RequestOption) (Customer, *http.Response, error) {
+	return doPost[Customer](s.sling, "customers", customerBody, opts...)
+}
+
+// Update updates an existing customer, changing only the fields set in
+// customerBody
+func (s *CustomerService) Update(customerId string, customerBody *CustomerRequest, opts ...RequestOption) (Customer, *http.Response, error) {
+	return doPatch[Customer](s.sling, fmt.Sprintf("customers/%s", customerId), customerBody, opts...)
+}
+
+// PaymentList returns all customer payments created
+func (s *CustomerService) PaymentList(customerId string, params *ListParams, opts ...RequestOption) (PaymentList, *http.Response, error) {
+	return doGet[PaymentList](s.sling, fmt.Sprintf("customers/%s/payments", customerId), params, opts...)
+}
+
+// Payment creates a new customer payment
+func (s *CustomerService) Payment(customerId string, paymentBody PaymentRequest, opts ...RequestOption) (Payment, *http.Response, error) {
+	paymentBody = s.paymentDefaults.apply(paymentBody)
+	validated := paymentBody
+	validated.CustomerID = customerId
+	if err := validated.Validate(); err != nil {
+		return Payment{}, nil, err
 	}
 
-	return *customers, resp, err
+	return doPost[Payment](s.sling, fmt.Sprintf("customers/%s/payments", customerId), paymentBody, opts...)
 }
 
-// Fetch returns a created customer
-func (s *CustomerService) Fetch(customerId string) (Customer, *http.Response, error) {
-	customer := new(Customer)
-	mollieError := new(MollieError)
-	resp, err := s.sling.New().Get(fmt.Sprintf("customers/%s", customerId)).Receive(customer, mollieError)
-	if err == nil && mollieError.Err.Type != "" {
-		err = mollieError
+// Iter returns an iterator over all customers, starting at params
+func (s *CustomerService) Iter(params *ListParams, opts ...RequestOption) *CustomerIterator {
+	it := new(CustomerIterator)
+	it.load = func(next string) (CustomerList, error) {
+		if next == "" {
+			page, _, err := s.List(params, opts...)
+			return page, err
+		}
+		page, _, err := doGetNext[CustomerList](s.sling, next, it.ctx, opts)
+		return page, err
 	}
-	return *customer, resp, err
+
+	return it
 }
 
-// Create creates a new customer
-func (s *CustomerService) Create(customerBody *CustomerRequest) (Customer, *http.Response, error) {
-	customer := new(Customer)
-	mollieError := new(MollieError)
-	resp, err := s.sling.New().Post("customers").BodyJSON(customerBody).Receive(customer, mollieError)
-	if err == nil && mollieError.Err.Type != "" {
-		err = mollieError
+// PaymentIter returns an iterator over all customer payments, starting at params
+func (s *CustomerService) PaymentIter(customerId string, params *ListParams, opts ...RequestOption) *PaymentIterator {
+	it := new(PaymentIterator)
+	it.load = func(next string) (PaymentList, error) {
+		if next == "" {
+			page, _, err := s.PaymentList(customerId, params, opts...)
+			return page, err
+		}
+		page, _, err := doGetNext[PaymentList](s.sling, next, it.ctx, opts)
+		return page, err
 	}
-	return *customer, resp, err
+
+	return it
 }
 
-// Update updates an existing customer
-func (s *CustomerService) Update(customerBody *CustomerRequest) (Customer, *http.Response, error) {
-	customer := new(Customer)
-	mollieError := new(MollieError)
-	resp, err := s.sling.New().Put("customers").BodyJSON(customerBody).Receive(customer, mollieError)
-	if err == nil && mollieError.Err.Type != "" {
-		err = mollieError
+// All returns all customers, following all pages up to MaxAllPages
+func (s *CustomerService) All(ctx context.Context, params *ListParams, opts ...RequestOption) ([]*Customer, error) {
+	it := s.Iter(params, contextOptions(ctx, opts)...)
+	it.limit(ctx, MaxAllPages)
+
+	var customers []*Customer
+	for it.Next() {
+		customers = append(customers, it.Customer())
 	}
-	return *customer, resp, err
+
+	return customers, it.Err()
 }
 
-// PaymentList returns all customer payments created
-func (s *CustomerService) PaymentList(customerId string, params *ListParams) (PaymentList, *http.Response, error) {
-	payments := new(PaymentList)
-	mollieError := new(MollieError)
-	resp, err := s.sling.New().Path(fmt.Sprintf("customers/%s/payments", customerId)).QueryStruct(params).Receive(payments, mollieError)
-	if err == nil && mollieError.Err.Type != "" {
-		err = mollieError
+// AllPayments returns all customer payments, following all pages up to MaxAllPages
+func (s *CustomerService) AllPayments(ctx context.Context, customerId string, params *ListParams, opts ...RequestOption) ([]*Payment, error) {
+	it := s.PaymentIter(customerId, params, contextOptions(ctx, opts)...)
+	it.limit(ctx, MaxAllPages)
+
+	var payments []*Payment
+	for it.Next() {
+		payments = append(payments, it.Payment())
 	}
 
-	return *payments, resp, err
+	return payments, it.Err()
 }
 
-// Payment creates a new customer payment
-func (s *CustomerService) Payment(customerId string, paymentBody PaymentRequest) (Payment, *http.Response, error) {
-	payment := new(Payment)
-	mollieError := new(MollieError)
-	resp, err := s.sling.New().Post(fmt.Sprintf("customers/%s/payments", customerId)).BodyJSON(paymentBody).Receive(payment, mollieError)
-	if err == nil && mollieError.Err.Type != "" {
-		err = mollieError
+// FindValidMandate returns the first mandate of the customer usable for
+// recurring payments with method, or any method if method is "". It returns
+// ErrNoValidMandate if there is none.
+func (s *CustomerService) FindValidMandate(ctx context.Context, customerId string, method string, opts ...RequestOption) (Mandate, error) {
+	mandates := &MandateService{sling: s.sling}
+	it := mandates.Iter(customerId, nil, contextOptions(ctx, opts)...)
+	it.limit(ctx, MaxAllPages)
+
+	for it.Next() {
+		mandate := it.Mandate()
+		if mandate.IsUsable() && (method == "" || mandate.Method == method) {
+			return *mandate, nil
+		}
+	}
+	if err := it.Err(); err != nil {
+		return Mandate{}, err
 	}
 
-	return *payment, resp, err
+	return Mandate{}, ErrNoValidMandate
 }
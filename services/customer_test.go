@@ -0,0 +1,31 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestCustomerUpdate(t *testing.T) {
+	customers := NewCustomerService("test_x", withTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/v2/customers/cst_8wmqcHMN4U" {
+			t.Errorf("got %s %s, want PATCH /v2/customers/cst_8wmqcHMN4U", r.Method, r.URL.Path)
+		}
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+		}
+		if len(body) != 1 || body["email"] != "new@example.org" {
+			t.Errorf("got body %v, want only the email", body)
+		}
+		writeJSON(w, http.StatusOK, `{"resource":"customer","id":"cst_8wmqcHMN4U","email":"new@example.org"}`)
+	}))
+
+	customer, _, err := customers.Update("cst_8wmqcHMN4U", &CustomerRequest{Email: "new@example.org"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if customer.ID != "cst_8wmqcHMN4U" || customer.Email != "new@example.org" {
+		t.Errorf("got customer %+v", customer)
+	}
+}
@@ -0,0 +1,69 @@
+package services
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// dateLayout is the layout of date-only fields sent and returned by Mollie
+const dateLayout = "2006-01-02"
+
+// Date is a date without a time, encoded as YYYY-MM-DD
+type Date struct {
+	time.Time
+}
+
+// NewDate returns the Date for year, month and day
+func NewDate(year int, month time.Month, day int) Date {
+	return Date{time.Date(year, month, day, 0, 0, 0, 0, time.UTC)}
+}
+
+// DateOf returns the Date of t, in the location of t
+func DateOf(t time.Time) Date {
+	return NewDate(t.Date())
+}
+
+// ParseDate parses a YYYY-MM-DD date
+func ParseDate(s string) (Date, error) {
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		return Date{}, err
+	}
+
+	return Date{t}, nil
+}
+
+// String returns the date as YYYY-MM-DD
+func (d Date) String() string {
+	return d.Format(dateLayout)
+}
+
+// MarshalJSON encodes the date as YYYY-MM-DD, or null for the zero date
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON decodes a YYYY-MM-DD date, leaving the date zero for null or
+// an empty string
+func (d *Date) UnmarshalJSON(data []byte) error {
+	var s *string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == nil || *s == "" {
+		*d = Date{}
+		return nil
+	}
+
+	date, err := ParseDate(*s)
+	if err != nil {
+		return err
+	}
+	*d = date
+
+	return nil
+}
@@ -0,0 +1,210 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// IDEALDetails are the payment details for iDEAL payments
+// https://docs.mollie.com/reference/v2/payments-api/get-payment#ideal
+type IDEALDetails struct {
+	ConsumerName    string `json:"consumerName" bson:"consumerName"`
+	ConsumerAccount string `json:"consumerAccount" bson:"consumerAccount"`
+	ConsumerBic     string `json:"consumerBic" bson:"consumerBic"`
+}
+
+// CreditCardDetails are the payment details for credit card payments
+// https://docs.mollie.com/reference/v2/payments-api/get-payment#credit-card
+type CreditCardDetails struct {
+	CardHolder      string `json:"cardHolder" bson:"cardHolder"`
+	CardNumber      string `json:"cardNumber" bson:"cardNumber"`
+	CardFingerprint string `json:"cardFingerprint" bson:"cardFingerprint"`
+	CardAudience    string `json:"cardAudience" bson:"cardAudience"`
+	CardLabel       string `json:"cardLabel" bson:"cardLabel"`
+	CardCountryCode string `json:"cardCountryCode" bson:"cardCountryCode"`
+	CardSecurity    string `json:"cardSecurity" bson:"cardSecurity"`
+	FeeRegion       string `json:"feeRegion" bson:"feeRegion"`
+	FailureReason   string `json:"failureReason" bson:"failureReason"`
+	FailureMessage  string `json:"failureMessage" bson:"failureMessage"`
+	Wallet          string `json:"wallet" bson:"wallet"`
+}
+
+// BancontactDetails are the payment details for Bancontact payments
+// https://docs.mollie.com/reference/v2/payments-api/get-payment#bancontact
+type BancontactDetails struct {
+	CardNumber      string `json:"cardNumber" bson:"cardNumber"`
+	CardFingerprint string `json:"cardFingerprint" bson:"cardFingerprint"`
+	ConsumerName    string `json:"consumerName" bson:"consumerName"`
+	ConsumerAccount string `json:"consumerAccount" bson:"consumerAccount"`
+	ConsumerBic     string `json:"consumerBic" bson:"consumerBic"`
+	FailureReason   string `json:"failureReason" bson:"failureReason"`
+}
+
+// BankTransferDetails are the payment details for bank transfer payments
+// https://docs.mollie.com/reference/v2/payments-api/get-payment#bank-transfer
+type BankTransferDetails struct {
+	BankName          string `json:"bankName" bson:"bankName"`
+	BankAccount       string `json:"bankAccount" bson:"bankAccount"`
+	BankBic           string `json:"bankBic" bson:"bankBic"`
+	TransferReference string `json:"transferReference" bson:"transferReference"`
+	ConsumerName      string `json:"consumerName" bson:"consumerName"`
+	ConsumerAccount   string `json:"consumerAccount" bson:"consumerAccount"`
+	ConsumerBic       string `json:"consumerBic" bson:"consumerBic"`
+	BillingEmail      string `json:"billingEmail" bson:"billingEmail"`
+}
+
+// PayPalDetails are the payment details for PayPal payments
+// https://docs.mollie.com/reference/v2/payments-api/get-payment#paypal
+type PayPalDetails struct {
+	ConsumerName     string  `json:"consumerName" bson:"consumerName"`
+	ConsumerAccount  string  `json:"consumerAccount" bson:"consumerAccount"`
+	PaypalReference  string  `json:"paypalReference" bson:"paypalReference"`
+	PaypalPayerID    string  `json:"paypalPayerId" bson:"paypalPayerId"`
+	SellerProtection string  `json:"sellerProtection" bson:"sellerProtection"`
+	PaypalFee        *Amount `json:"paypalFee" bson:"paypalFee"`
+}
+
+// DirectDebitDetails are the payment details for SEPA direct debit payments
+// https://docs.mollie.com/reference/v2/payments-api/get-payment#sepa-direct-debit
+type DirectDebitDetails struct {
+	TransferReference  string `json:"transferReference" bson:"transferReference"`
+	CreditorIdentifier string `json:"creditorIdentifier" bson:"creditorIdentifier"`
+	ConsumerName       string `json:"consumerName" bson:"consumerName"`
+	ConsumerAccount    string `json:"consumerAccount" bson:"consumerAccount"`
+	ConsumerBic        string `json:"consumerBic" bson:"consumerBic"`
+	DueDate            *Date  `json:"dueDate" bson:"dueDate"`
+	SignatureDate      *Date  `json:"signatureDate" bson:"signatureDate"`
+	BankReasonCode     string `json:"bankReasonCode" bson:"bankReasonCode"`
+	BankReason         string `json:"bankReason" bson:"bankReason"`
+	EndToEndIdentifier string `json:"endToEndIdentifier" bson:"endToEndIdentifier"`
+	MandateReference   string `json:"mandateReference" bson:"mandateReference"`
+	BatchReference     string `json:"batchReference" bson:"batchReference"`
+	FileReference      string `json:"fileReference" bson:"fileReference"`
+}
+
+// GiftCardDetails are the payment details for gift card payments
+// https://docs.mollie.com/reference/v2/payments-api/get-payment#gift-cards
+type GiftCardDetails struct {
+	VoucherNumber string        `json:"voucherNumber" bson:"voucherNumber"`
+	GiftCards     []AppliedCard `json:"giftcards" bson:"giftcards"`
+	Remainder
+}
+
+// VoucherDetails are the payment details for voucher payments
+// https://docs.mollie.com/reference/v2/payments-api/get-payment#vouchers
+type VoucherDetails struct {
+	Issuer   string        `json:"issuer" bson:"issuer"`
+	Vouchers []AppliedCard `json:"vouchers" bson:"vouchers"`
+	Remainder
+}
+
+// AppliedCard is a gift card or voucher applied to a payment
+type AppliedCard struct {
+	Issuer        string `json:"issuer" bson:"issuer"`
+	Amount        Amount `json:"amount" bson:"amount"`
+	VoucherNumber string `json:"voucherNumber" bson:"voucherNumber"`
+}
+
+// Remainder is the part of a gift card or voucher payment paid with another
+// method. RemainderDetails is only returned when fetching the payment with
+// WithInclude(IncludeRemainderDetails).
+type Remainder struct {
+	RemainderAmount  *Amount         `json:"remainderAmount" bson:"remainderAmount"`
+	RemainderMethod  string          `json:"remainderMethod" bson:"remainderMethod"`
+	RemainderDetails json.RawMessage `json:"remainderDetails" bson:"remainderDetails"`
+}
+
+// DecodeRemainderDetails decodes the remainder payment details into v
+func (r Remainder) DecodeRemainderDetails(v interface{}) error {
+	return decodeRaw(r.RemainderDetails, v)
+}
+
+// DecodeDetails decodes the payment details into v
+func (p Payment) DecodeDetails(v interface{}) error {
+	return decodeRaw(p.Details, v)
+}
+
+// IDEALDetails returns the details of an iDEAL payment
+func (p Payment) IDEALDetails() (*IDEALDetails, error) {
+	details := new(IDEALDetails)
+	return details, p.decodeMethodDetails(MethodIDEAL, details)
+}
+
+// CreditCardDetails returns the details of a credit card payment
+func (p Payment) CreditCardDetails() (*CreditCardDetails, error) {
+	details := new(CreditCardDetails)
+	return details, p.decodeMethodDetails(MethodCreditCard, details)
+}
+
+// BancontactDetails returns the details of a Bancontact payment
+func (p Payment) BancontactDetails() (*BancontactDetails, error) {
+	details := new(BancontactDetails)
+	return details, p.decodeMethodDetails(MethodBancontact, details)
+}
+
+// BankTransferDetails returns the details of a bank transfer payment
+func (p Payment) BankTransferDetails() (*BankTransferDetails, error) {
+	details := new(BankTransferDetails)
+	return details, p.decodeMethodDetails(MethodBankTransfer, details)
+}
+
+// PayPalDetails returns the details of a PayPal payment
+func (p Payment) PayPalDetails() (*PayPalDetails, error) {
+	details := new(PayPalDetails)
+	return details, p.decodeMethodDetails(MethodPayPal, details)
+}
+
+// DirectDebitDetails returns the details of a SEPA direct debit payment
+func (p Payment) DirectDebitDetails() (*DirectDebitDetails, error) {
+	details := new(DirectDebitDetails)
+	return details, p.decodeMethodDetails(MethodDirectDebit, details)
+}
+
+// GiftCardDetails returns the details of a gift card payment
+func (p Payment) GiftCardDetails() (*GiftCardDetails, error) {
+	details := new(GiftCardDetails)
+	return details, p.decodeMethodDetails(MethodGiftCard, details)
+}
+
+// VoucherDetails returns the details of a voucher payment
+func (p Payment) VoucherDetails() (*VoucherDetails, error) {
+	details := new(VoucherDetails)
+	return details, p.decodeMethodDetails(MethodVoucher, details)
+}
+
+// MethodDetails returns the details of the payment decoded into the typed
+// details struct for its method, eg. *IDEALDetails for an iDEAL payment.
+// Methods without a typed struct are returned as decoded by encoding/json.
+func (p Payment) MethodDetails() (interface{}, error) {
+	switch p.Method {
+	case MethodIDEAL:
+		return p.IDEALDetails()
+	case MethodCreditCard:
+		return p.CreditCardDetails()
+	case MethodBancontact:
+		return p.BancontactDetails()
+	case MethodBankTransfer:
+		return p.BankTransferDetails()
+	case MethodPayPal:
+		return p.PayPalDetails()
+	case MethodDirectDebit:
+		return p.DirectDebitDetails()
+	case MethodGiftCard:
+		return p.GiftCardDetails()
+	case MethodVoucher:
+		return p.VoucherDetails()
+	}
+
+	var details interface{}
+	return details, p.DecodeDetails(&details)
+}
+
+// decodeMethodDetails decodes the payment details into v if the payment
+// was made with method
+func (p Payment) decodeMethodDetails(method string, v interface{}) error {
+	if p.Method != method {
+		return fmt.Errorf("payment %s has method %q, not %q", p.ID, p.Method, method)
+	}
+
+	return p.DecodeDetails(v)
+}
@@ -0,0 +1,52 @@
+package services
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestRemainderDetails(t *testing.T) {
+	const remainder = `"remainderAmount":{"currency":"EUR","value":"5.00"},"remainderMethod":"ideal","remainderDetails":{"consumerName":"T. TEST","consumerAccount":"NL91ABNA0417164300","consumerBic":"ABNANL2A"}`
+
+	tests := []struct {
+		name    string
+		payment string
+	}{
+		{"gift card", `{"id":"tr_1","method":"giftcard","details":{"voucherNumber":"606436353088147****","giftcards":[{"issuer":"fashioncheque","amount":{"currency":"EUR","value":"10.00"},"voucherNumber":"606436353088147****"}],` + remainder + `}}`},
+		{"voucher", `{"id":"tr_1","method":"voucher","details":{"issuer":"edenred-belgium-eco","vouchers":[{"issuer":"edenred-belgium-eco","amount":{"currency":"EUR","value":"10.00"}}],` + remainder + `}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var payment Payment
+			if err := json.Unmarshal([]byte(tt.payment), &payment); err != nil {
+				t.Fatal(err)
+			}
+			details, err := payment.MethodDetails()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var r Remainder
+			switch d := details.(type) {
+			case *GiftCardDetails:
+				r = d.Remainder
+			case *VoucherDetails:
+				r = d.Remainder
+			default:
+				t.Fatalf("got details %T", details)
+			}
+			if r.RemainderMethod != MethodIDEAL || r.RemainderAmount == nil || r.RemainderAmount.Number != "5.00" {
+				t.Errorf("got remainder %+v", r)
+			}
+
+			var ideal IDEALDetails
+			if err := r.DecodeRemainderDetails(&ideal); err != nil {
+				t.Fatal(err)
+			}
+			if ideal.ConsumerName != "T. TEST" || ideal.ConsumerBic != "ABNANL2A" {
+				t.Errorf("got remainder details %+v", ideal)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// maxWebhookBody is the maximum size of a webhook request body read
+const maxWebhookBody = 1 << 20
+
+// ErrNoWebhookID is returned for a webhook call without a resource or event
+var ErrNoWebhookID = errors.New("webhook call has no id")
+
+// Event is an event delivered by a webhook subscription
+// https://docs.mollie.com/reference/webhooks-new
+type Event struct {
+	Resource  string     `json:"resource" bson:"resource"`
+	ID        string     `json:"id" bson:"id"`
+	Type      string     `json:"type" bson:"type"`
+	EntityID  string     `json:"entityId" bson:"entityId"`
+	CreatedAt *time.Time `json:"createdAt" bson:"createdAt"`
+	Embedded  struct {
+		// Entity is the resource the event is about, if included
+		Entity json.RawMessage `json:"entity,omitempty" bson:"entity,omitempty"`
+	} `json:"_embedded" bson:"_embedded"`
+	Links EventLinks `json:"_links" bson:"_links"`
+}
+
+// EventLinks represents the _links object returned in an Event
+type EventLinks struct {
+	Self          Link `json:"self" bson:"self"`
+	Entity        Link `json:"entity" bson:"entity"`
+	Documentation Link `json:"documentation" bson:"documentation"`
+}
+
+// DecodeEntity decodes the embedded entity of the event into v, eg. a
+// Payment. It returns an error if the entity is not included.
+func (e Event) DecodeEntity(v interface{}) error {
+	if len(e.Embedded.Entity) == 0 {
+		return errors.New("event has no embedded entity")
+	}
+
+	return json.Unmarshal(e.Embedded.Entity, v)
+}
+
+// WebhookCall is a call to a webhook URL, either in the classic form with
+// only the id of the changed resource, or carrying an event
+type WebhookCall struct {
+	// ID is the resource id of a classic webhook call
+	ID string
+	// Event is the event of an event webhook call
+	Event *Event
+}
+
+// EntityID returns the id of the resource the webhook call is about
+func (c WebhookCall) EntityID() string {
+	if c.Event != nil {
+		return c.Event.EntityID
+	}
+
+	return c.ID
+}
+
+// ParseEvent decodes an event webhook payload
+func ParseEvent(data []byte) (Event, error) {
+	var event Event
+	if err := json.Unmarshal(data, &event); err != nil {
+		return Event{}, err
+	}
+	if event.ID == "" {
+		return Event{}, ErrNoWebhookID
+	}
+
+	return event, nil
+}
+
+// ParseWebhook parses a webhook call: a form encoded id for classic webhooks
+// or a JSON event for webhook subscriptions
+func ParseWebhook(r *http.Request) (WebhookCall, error) {
+	data, err := readWebhookBody(r)
+	if err != nil {
+		return WebhookCall{}, err
+	}
+
+	return parseWebhookBody(r.Header.Get("Content-Type"), data)
+}
+
+// readWebhookBody reads the body of a webhook call, up to maxWebhookBody
+func readWebhookBody(r *http.Request) ([]byte, error) {
+	return io.ReadAll(io.LimitReader(r.Body, maxWebhookBody))
+}
+
+// parseWebhookBody parses the body of a webhook call of contentType
+func parseWebhookBody(contentType string, data []byte) (WebhookCall, error) {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if !isJSON(mediaType) {
+		form, err := url.ParseQuery(string(data))
+		if err != nil {
+			return WebhookCall{}, err
+		}
+		id := form.Get("id")
+		if id == "" {
+			return WebhookCall{}, ErrNoWebhookID
+		}
+		return WebhookCall{ID: id}, nil
+	}
+
+	event, err := ParseEvent(data)
+	if err != nil {
+		return WebhookCall{}, err
+	}
+
+	return WebhookCall{Event: &event}, nil
+}
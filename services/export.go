@@ -0,0 +1,216 @@
+package services
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+)
+
+// CSVColumn is a column of a CSV export, with the header and the value of
+// the column for a resource
+type CSVColumn[T any] struct {
+	Header string
+	Value  func(T) string
+}
+
+// PaymentColumns are the default columns of a payment CSV export
+var PaymentColumns = []CSVColumn[*Payment]{
+	{"id", func(p *Payment) string { return p.ID }},
+	{"createdAt", func(p *Payment) string { return formatTime(p.CreatedAt) }},
+	{"paidAt", func(p *Payment) string { return formatTime(p.PaidAt) }},
+	{"status", func(p *Payment) string { return p.Status }},
+	{"method", func(p *Payment) string { return p.Method }},
+	{"currency", func(p *Payment) string { return p.Amount.Currency }},
+	{"amount", func(p *Payment) string { return p.Amount.Number }},
+	{"amountRefunded", func(p *Payment) string { return formatAmount(p.AmountRefunded) }},
+	{"settlementAmount", func(p *Payment) string { return formatAmount(p.SettlementAmount) }},
+	{"description", func(p *Payment) string { return p.Description }},
+	{"customerId", func(p *Payment) string { return p.CustomerID }},
+	{"settlementId", func(p *Payment) string { return p.SettlementID }},
+}
+
+// RefundColumns are the default columns of a refund CSV export
+var RefundColumns = []CSVColumn[*PaymentRefund]{
+	{"id", func(r *PaymentRefund) string { return r.ID }},
+	{"createdAt", func(r *PaymentRefund) string { return formatTime(r.CreatedAt) }},
+	{"status", func(r *PaymentRefund) string { return string(r.Status) }},
+	{"paymentId", func(r *PaymentRefund) string { return r.PaymentID }},
+	{"currency", func(r *PaymentRefund) string { return r.Amount.Currency }},
+	{"amount", func(r *PaymentRefund) string { return r.Amount.Number }},
+	{"settlementAmount", func(r *PaymentRefund) string { return formatAmount(r.SettlementAmount) }},
+	{"description", func(r *PaymentRefund) string { return r.Description }},
+}
+
+// ChargebackColumns are the default columns of a chargeback CSV export
+var ChargebackColumns = []CSVColumn[*PaymentChargeback]{
+	{"id", func(c *PaymentChargeback) string { return c.ID }},
+	{"createdAt", func(c *PaymentChargeback) string { return formatTime(c.CreatedAt) }},
+	{"reversedAt", func(c *PaymentChargeback) string { return formatTime(c.ReversedAt) }},
+	{"paymentId", func(c *PaymentChargeback) string { return c.PaymentID }},
+	{"currency", func(c *PaymentChargeback) string { return c.Amount.Currency }},
+	{"amount", func(c *PaymentChargeback) string { return c.Amount.Number }},
+	{"settlementAmount", func(c *PaymentChargeback) string { return formatAmount(c.SettlementAmount) }},
+	{"reason", func(c *PaymentChargeback) string {
+		if c.Reason == nil {
+			return ""
+		}
+		return c.Reason.Code
+	}},
+}
+
+// SettlementColumns are the default columns of a settlement CSV export
+var SettlementColumns = []CSVColumn[*Settlement]{
+	{"id", func(s *Settlement) string { return s.ID }},
+	{"reference", func(s *Settlement) string { return s.Reference }},
+	{"createdAt", func(s *Settlement) string { return formatTime(s.CreatedAt) }},
+	{"settledAt", func(s *Settlement) string { return formatTime(s.SettledAt) }},
+	{"status", func(s *Settlement) string { return s.Status }},
+	{"currency", func(s *Settlement) string { return s.Amount.Currency }},
+	{"amount", func(s *Settlement) string { return s.Amount.Number }},
+	{"invoiceId", func(s *Settlement) string { return s.InvoiceID }},
+}
+
+// ReconciliationColumns are the columns of a reconciliation totals CSV export
+var ReconciliationColumns = []CSVColumn[ReconciliationTotal]{
+	{"key", func(t ReconciliationTotal) string { return t.Key }},
+	{"count", func(t ReconciliationTotal) string { return strconv.Itoa(t.Count) }},
+	{"currency", func(t ReconciliationTotal) string { return t.Amount.Currency }},
+	{"amount", func(t ReconciliationTotal) string { return t.Amount.Number }},
+}
+
+// WriteCSV writes a header row and a row for each item to w. Nil columns
+// write nothing.
+func WriteCSV[T any](w io.Writer, items []T, columns []CSVColumn[T]) error {
+	i := -1
+	return streamCSV(w, columns, func() (T, bool, error) {
+		i++
+		if i >= len(items) {
+			var zero T
+			return zero, false, nil
+		}
+		return items[i], true, nil
+	})
+}
+
+// ExportPaymentsCSV streams the payments of it to w, one page at a time, with
+// the columns or PaymentColumns if none are given
+func ExportPaymentsCSV(w io.Writer, it *PaymentIterator, columns ...CSVColumn[*Payment]) error {
+	if len(columns) == 0 {
+		columns = PaymentColumns
+	}
+	return streamCSV(w, columns, iteratorSource(&it.pageIterator))
+}
+
+// ExportRefundsCSV streams the refunds of it to w, one page at a time, with
+// the columns or RefundColumns if none are given
+func ExportRefundsCSV(w io.Writer, it *PaymentRefundIterator, columns ...CSVColumn[*PaymentRefund]) error {
+	if len(columns) == 0 {
+		columns = RefundColumns
+	}
+	return streamCSV(w, columns, iteratorSource(&it.pageIterator))
+}
+
+// ExportChargebacksCSV streams the chargebacks of it to w, one page at a time,
+// with the columns or ChargebackColumns if none are given
+func ExportChargebacksCSV(w io.Writer, it *PaymentChargebackIterator, columns ...CSVColumn[*PaymentChargeback]) error {
+	if len(columns) == 0 {
+		columns = ChargebackColumns
+	}
+	return streamCSV(w, columns, iteratorSource(&it.pageIterator))
+}
+
+// ExportSettlementsCSV writes the settlements to w, with the columns or
+// SettlementColumns if none are given
+func ExportSettlementsCSV(w io.Writer, settlements []*Settlement, columns ...CSVColumn[*Settlement]) error {
+	if len(columns) == 0 {
+		columns = SettlementColumns
+	}
+	return WriteCSV(w, settlements, columns)
+}
+
+// ExportReconciliationCSV writes the per-day and per-method totals of a
+// reconciliation to w, keyed "day:YYYY-MM-DD" and "method:<method>", followed
+// by the type totals and the settlement amount
+func ExportReconciliationCSV(w io.Writer, r *Reconciliation) error {
+	var rows []ReconciliationTotal
+	for _, day := range r.Days {
+		day.Key = "day:" + day.Key
+		rows = append(rows, day)
+	}
+	for _, method := range r.Methods {
+		method.Key = "method:" + method.Key
+		rows = append(rows, method)
+	}
+	rows = append(rows, r.Payments, r.Refunds, r.Chargebacks, r.Costs,
+		ReconciliationTotal{Key: "total", Amount: r.Total},
+		ReconciliationTotal{Key: "settlement", Amount: r.Settlement.Amount},
+		ReconciliationTotal{Key: "difference", Amount: r.Difference},
+	)
+
+	return WriteCSV(w, rows, ReconciliationColumns)
+}
+
+// iteratorSource returns a CSV row source reading the items of a list iterator
+func iteratorSource[T any](it *pageIterator[T]) func() (T, bool, error) {
+	return func() (T, bool, error) {
+		if !it.Next() {
+			var zero T
+			return zero, false, it.Err()
+		}
+		return it.page.Items[it.index], true, nil
+	}
+}
+
+// streamCSV writes a header row and a row for each item returned by next,
+// writing rows as they are read so large exports are not held in memory
+func streamCSV[T any](w io.Writer, columns []CSVColumn[T], next func() (T, bool, error)) error {
+	if len(columns) == 0 {
+		return nil
+	}
+
+	cw := csv.NewWriter(w)
+	record := make([]string, len(columns))
+	for i, column := range columns {
+		record[i] = column.Header
+	}
+	if err := cw.Write(record); err != nil {
+		return err
+	}
+
+	for {
+		item, ok, err := next()
+		if err != nil {
+			cw.Flush()
+			return err
+		}
+		if !ok {
+			break
+		}
+		for i, column := range columns {
+			record[i] = column.Value(item)
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// formatTime formats an optional timestamp as RFC 3339
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// formatAmount formats the value of an optional amount
+func formatAmount(a *Amount) string {
+	if a == nil {
+		return ""
+	}
+	return a.Number
+}
@@ -0,0 +1,134 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// IntervalUnit is the unit of a subscription interval
+type IntervalUnit string
+
+// Subscription interval units
+const (
+	IntervalDays   IntervalUnit = "days"
+	IntervalWeeks  IntervalUnit = "weeks"
+	IntervalMonths IntervalUnit = "months"
+)
+
+// maxIntervalCount is the longest interval Mollie allows per unit, one year
+var maxIntervalCount = map[IntervalUnit]int{
+	IntervalDays:   365,
+	IntervalWeeks:  52,
+	IntervalMonths: 12,
+}
+
+// Interval is a subscription interval such as "1 month" or "14 days"
+// https://docs.mollie.com/reference/v2/subscriptions-api/create-subscription#parameters
+type Interval struct {
+	Count int
+	Unit  IntervalUnit
+}
+
+// ParseInterval parses an interval such as "1 month", "14 days" or "3 weeks"
+func ParseInterval(s string) (Interval, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return Interval{}, fmt.Errorf("invalid interval %q", s)
+	}
+
+	count, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return Interval{}, fmt.Errorf("invalid interval %q: %v", s, err)
+	}
+
+	unit := IntervalUnit(fields[1])
+	if !strings.HasSuffix(fields[1], "s") {
+		unit += "s"
+	}
+	if _, ok := maxIntervalCount[unit]; !ok {
+		return Interval{}, fmt.Errorf("invalid interval %q: unknown unit %q", s, fields[1])
+	}
+
+	return Interval{Count: count, Unit: unit}, nil
+}
+
+// Validate returns an error if Mollie does not accept the interval
+func (i Interval) Validate() error {
+	max, ok := maxIntervalCount[i.Unit]
+	if !ok {
+		return fmt.Errorf("invalid interval unit %q", string(i.Unit))
+	}
+	if i.Count < 1 || i.Count > max {
+		return fmt.Errorf("invalid interval %q: count must be between 1 and %d", i.String(), max)
+	}
+
+	return nil
+}
+
+// String returns the interval as sent to Mollie, eg. "1 month" or "14 days"
+func (i Interval) String() string {
+	unit := string(i.Unit)
+	if i.Count == 1 {
+		unit = strings.TrimSuffix(unit, "s")
+	}
+
+	return fmt.Sprintf("%d %s", i.Count, unit)
+}
+
+// Next returns the charge date one interval after t. Monthly intervals fall
+// on the last day of months shorter than the day of t, eg. January 31 plus
+// one month is February 28 (or 29).
+func (i Interval) Next(t time.Time) time.Time {
+	switch i.Unit {
+	case IntervalDays:
+		return t.AddDate(0, 0, i.Count)
+	case IntervalWeeks:
+		return t.AddDate(0, 0, 7*i.Count)
+	case IntervalMonths:
+		year, month, day := t.Date()
+		month += time.Month(i.Count)
+		if last := time.Date(year, month+1, 0, 0, 0, 0, 0, t.Location()).Day(); day > last {
+			day = last
+		}
+		hour, min, sec := t.Clock()
+		return time.Date(year, month, day, hour, min, sec, t.Nanosecond(), t.Location())
+	}
+
+	return t
+}
+
+// MarshalJSON encodes the interval as a string, failing for invalid intervals.
+// The zero interval is encoded as an empty string.
+func (i Interval) MarshalJSON() ([]byte, error) {
+	if i == (Interval{}) {
+		return json.Marshal("")
+	}
+	if err := i.Validate(); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON decodes an interval string
+func (i *Interval) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		*i = Interval{}
+		return nil
+	}
+
+	interval, err := ParseInterval(s)
+	if err != nil {
+		return err
+	}
+	*i = interval
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package services
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestParseInterval(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    Interval
+		wantErr bool
+	}{
+		{"1 month", Interval{1, IntervalMonths}, false},
+		{"3 months", Interval{3, IntervalMonths}, false},
+		{"14 days", Interval{14, IntervalDays}, false},
+		{"1 day", Interval{1, IntervalDays}, false},
+		{"2 weeks", Interval{2, IntervalWeeks}, false},
+		{"  1   week ", Interval{1, IntervalWeeks}, false},
+		{"month", Interval{}, true},
+		{"1", Interval{}, true},
+		{"one month", Interval{}, true},
+		{"1 year", Interval{}, true},
+		{"1 month extra", Interval{}, true},
+		{"", Interval{}, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseInterval(tt.s)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseInterval(%q): got error %v, want error %v", tt.s, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseInterval(%q) = %+v, want %+v", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestIntervalValidate(t *testing.T) {
+	tests := []struct {
+		interval Interval
+		valid    bool
+	}{
+		{Interval{1, IntervalDays}, true},
+		{Interval{365, IntervalDays}, true},
+		{Interval{366, IntervalDays}, false},
+		{Interval{52, IntervalWeeks}, true},
+		{Interval{53, IntervalWeeks}, false},
+		{Interval{12, IntervalMonths}, true},
+		{Interval{13, IntervalMonths}, false},
+		{Interval{0, IntervalMonths}, false},
+		{Interval{-1, IntervalDays}, false},
+		{Interval{1, "years"}, false},
+		{Interval{}, false},
+	}
+
+	for _, tt := range tests {
+		err := tt.interval.Validate()
+		if valid := err == nil; valid != tt.valid {
+			t.Errorf("%+v: got error %v, want valid %v", tt.interval, err, tt.valid)
+		}
+	}
+}
+
+func TestIntervalString(t *testing.T) {
+	tests := []struct {
+		interval Interval
+		want     string
+	}{
+		{Interval{1, IntervalMonths}, "1 month"},
+		{Interval{2, IntervalMonths}, "2 months"},
+		{Interval{1, IntervalWeeks}, "1 week"},
+		{Interval{14, IntervalDays}, "14 days"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.interval.String(); got != tt.want {
+			t.Errorf("%+v: got %q, want %q", tt.interval, got, tt.want)
+		}
+		parsed, err := ParseInterval(tt.want)
+		if err != nil || parsed != tt.interval {
+			t.Errorf("ParseInterval(%q) = %+v, %v, want %+v", tt.want, parsed, err, tt.interval)
+		}
+	}
+}
+
+func TestIntervalNext(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		interval Interval
+		from     time.Time
+		want     time.Time
+	}{
+		{Interval{1, IntervalDays}, date(2024, 12, 31), date(2025, 1, 1)},
+		{Interval{14, IntervalDays}, date(2024, 2, 20), date(2024, 3, 5)},
+		{Interval{1, IntervalWeeks}, date(2024, 2, 26), date(2024, 3, 4)},
+		{Interval{1, IntervalMonths}, date(2024, 1, 15), date(2024, 2, 15)},
+		{Interval{1, IntervalMonths}, date(2024, 1, 31), date(2024, 2, 29)},
+		{Interval{1, IntervalMonths}, date(2023, 1, 31), date(2023, 2, 28)},
+		{Interval{1, IntervalMonths}, date(2024, 3, 31), date(2024, 4, 30)},
+		{Interval{2, IntervalMonths}, date(2024, 12, 31), date(2025, 2, 28)},
+		{Interval{3, IntervalMonths}, date(2024, 11, 30), date(2025, 2, 28)},
+		{Interval{12, IntervalMonths}, date(2024, 2, 29), date(2025, 2, 28)},
+		{Interval{1, IntervalMonths}, date(2024, 2, 29), date(2024, 3, 29)},
+		{Interval{1, IntervalMonths}, time.Date(2024, 1, 31, 13, 30, 0, 0, time.UTC), time.Date(2024, 2, 29, 13, 30, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		if got := tt.interval.Next(tt.from); !got.Equal(tt.want) {
+			t.Errorf("%s after %s: got %s, want %s", tt.interval, tt.from.Format(time.RFC3339), got.Format(time.RFC3339), tt.want.Format(time.RFC3339))
+		}
+	}
+}
+
+func TestIntervalJSON(t *testing.T) {
+	tests := []struct {
+		interval Interval
+		json     string
+	}{
+		{Interval{1, IntervalMonths}, `"1 month"`},
+		{Interval{14, IntervalDays}, `"14 days"`},
+		{Interval{}, `""`},
+	}
+
+	for _, tt := range tests {
+		data, err := json.Marshal(tt.interval)
+		if err != nil || string(data) != tt.json {
+			t.Errorf("%+v encoded as %s, %v, want %s", tt.interval, data, err, tt.json)
+		}
+		var decoded Interval
+		if err := json.Unmarshal([]byte(tt.json), &decoded); err != nil || decoded != tt.interval {
+			t.Errorf("%s decoded as %+v, %v, want %+v", tt.json, decoded, err, tt.interval)
+		}
+	}
+
+	if _, err := json.Marshal(Interval{13, IntervalMonths}); err == nil {
+		t.Error("expected an error encoding an invalid interval")
+	}
+}
@@ -0,0 +1,48 @@
+package services
+
+// LineType is the type of a payment or order line
+// https://docs.mollie.com/reference/v2/orders-api/create-order#order-lines-details
+type LineType string
+
+// Line types
+const (
+	LineTypePhysical    LineType = "physical"
+	LineTypeDigital     LineType = "digital"
+	LineTypeDiscount    LineType = "discount"
+	LineTypeShippingFee LineType = "shipping_fee"
+	LineTypeStoreCredit LineType = "store_credit"
+	LineTypeGiftCard    LineType = "gift_card"
+	LineTypeSurcharge   LineType = "surcharge"
+)
+
+// Valid returns true if t is a line type known to Mollie
+func (t LineType) Valid() bool {
+	switch t {
+	case LineTypePhysical, LineTypeDigital, LineTypeDiscount, LineTypeShippingFee,
+		LineTypeStoreCredit, LineTypeGiftCard, LineTypeSurcharge:
+		return true
+	}
+	return false
+}
+
+// LineCategory is the voucher category of a line, deciding which meal, eco
+// or gift vouchers can pay for it
+// https://docs.mollie.com/reference/v2/payments-api/create-payment#payment-lines
+type LineCategory string
+
+// Line categories
+const (
+	LineCategoryMeal         LineCategory = "meal"
+	LineCategoryEco          LineCategory = "eco"
+	LineCategoryGift         LineCategory = "gift"
+	LineCategorySportCulture LineCategory = "sport_culture"
+)
+
+// Valid returns true if c is a line category known to Mollie
+func (c LineCategory) Valid() bool {
+	switch c {
+	case LineCategoryMeal, LineCategoryEco, LineCategoryGift, LineCategorySportCulture:
+		return true
+	}
+	return false
+}
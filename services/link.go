@@ -0,0 +1,90 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/dghubble/sling"
+)
+
+// ErrEmptyLink is returned when resolving a link without an href
+var ErrEmptyLink = errors.New("link has no href")
+
+// Link is a link to a related resource or document
+// https://docs.mollie.com/guides/common-data-types#url-object
+type Link struct {
+	Href string `json:"href" bson:"href"`
+	Type string `json:"type,omitempty" bson:"type,omitempty"`
+}
+
+// MarshalJSON encodes the link, or null for a link without an href, the way
+// Mollie sends absent links
+func (l Link) MarshalJSON() ([]byte, error) {
+	if l.Href == "" {
+		return []byte("null"), nil
+	}
+
+	type link Link
+	return json.Marshal(link(l))
+}
+
+// LinkService provides methods for following resource links.
+type LinkService struct {
+	sling *sling.Sling
+	doer  sling.Doer
+}
+
+// NewLinkService returns a new LinkService.
+func NewLinkService(accessToken string, opts ...ClientOption) *LinkService {
+	config := newClientConfig(opts)
+	doer := config.doer()
+
+	return &LinkService{
+		sling: newClient(accessToken, config, doer),
+		doer:  doer,
+	}
+}
+
+// Resolve fetches the resource a link points to and decodes it into dst. The
+// access token is only sent along to the Mollie API, not to other hosts.
+func (s *LinkService) Resolve(link Link, dst interface{}, opts ...RequestOption) (*http.Response, error) {
+	if link.Href == "" {
+		return nil, ErrEmptyLink
+	}
+
+	return receiveInto(s.sling.New().Get(link.Href), dst, newRequestOptions(opts))
+}
+
+// Download streams the document or image a link points to, such as a method
+// icon or an invoice PDF, to w. The access token is only sent along to the
+// Mollie API, not to other hosts. Nothing is written to w for error responses.
+func (s *LinkService) Download(link Link, w io.Writer, opts ...RequestOption) (*http.Response, error) {
+	if link.Href == "" {
+		return nil, ErrEmptyLink
+	}
+
+	o := newRequestOptions(opts)
+	req, err := s.sling.New().Get(link.Href).Request()
+	if err != nil {
+		return nil, err
+	}
+	if link.Type != "" {
+		req.Header.Set("Accept", link.Type)
+	}
+
+	resp, err := s.doer.Do(req.WithContext(o.context()))
+	if err != nil {
+		return resp, err
+	}
+	defer resp.Body.Close()
+
+	if err := readResponseError(resp); err != nil {
+		return resp, err
+	}
+
+	_, err = io.Copy(w, resp.Body)
+
+	return resp, err
+}
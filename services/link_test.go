@@ -0,0 +1,68 @@
+package services
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+)
+
+func TestLinkTestmode(t *testing.T) {
+	tests := []struct {
+		name  string
+		href  string
+		query string
+	}{
+		{"api", "https://api.mollie.com/v2/payments/tr_WDqYK6vllg", "testmode=true"},
+		{"foreign host", "https://files.example.org/invoices/inv_xBEbP9rvAq.pdf?signature=abc", "signature=abc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			links := NewLinkService("access_x", WithDefaultTestmode(), withTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.RawQuery != tt.query {
+					t.Errorf("got query %q, want %q", r.URL.RawQuery, tt.query)
+				}
+				w.Write([]byte("%PDF"))
+			}))
+
+			if _, err := links.Download(Link{Href: tt.href}, &bytes.Buffer{}); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestLinkCredentials(t *testing.T) {
+	tests := []struct {
+		name     string
+		href     string
+		wantAuth string
+	}{
+		{"api", "https://api.mollie.com/v2/payments/tr_WDqYK6vllg", "Bearer test_x"},
+		{"foreign host", "https://www.mollie.com/external/icons/payment-methods/ideal.svg", ""},
+		{"plain http", "http://api.mollie.com/v2/payments/tr_WDqYK6vllg", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var auth []string
+			links := NewLinkService("test_x", withTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				auth = append(auth, r.Header.Get("Authorization"))
+				writeJSON(w, http.StatusOK, `{"id":"tr_WDqYK6vllg"}`)
+			}))
+
+			var dst map[string]interface{}
+			if _, err := links.Resolve(Link{Href: tt.href}, &dst); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := links.Download(Link{Href: tt.href}, &bytes.Buffer{}); err != nil {
+				t.Fatal(err)
+			}
+			for _, got := range auth {
+				if got != tt.wantAuth {
+					t.Errorf("got Authorization %q, want %q", got, tt.wantAuth)
+				}
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"time"
@@ -8,31 +9,74 @@ import (
 	"github.com/dghubble/sling"
 )
 
+// Mandate statuses
+// https://docs.mollie.com/reference/v2/mandates-api/get-mandate#response
+const (
+	MandateStatusValid   = "valid"
+	MandateStatusPending = "pending"
+	MandateStatusInvalid = "invalid"
+)
+
 // Mandate is a customer mandate object
-// https://www.mollie.com/en/docs/reference/mandates/create#response
+// https://docs.mollie.com/reference/v2/mandates-api/get-mandate#response
 type Mandate struct {
-	Resource         string `json:"resource"`
-	Id               string `json:"id"`
-	Status           string `json:"status"`
-	Method           string `json:"method"`
-	CustomerId       string `json:"customerId"`
-	Details          MandateDetails
-	MandateReference string     `json:"mandateReference"`
-	SignatureDate    string     `json:"signatureDate"`
-	CreatedDateTime  *time.Time `json:"createdDateTime"`
+	Resource         string         `json:"resource" bson:"resource"`
+	ID               string         `json:"id" bson:"id"`
+	Mode             string         `json:"mode" bson:"mode"`
+	Status           string         `json:"status" bson:"status"`
+	Method           string         `json:"method" bson:"method"`
+	Details          MandateDetails `json:"details" bson:"details"`
+	MandateReference string         `json:"mandateReference" bson:"mandateReference"`
+	SignatureDate    *Date          `json:"signatureDate" bson:"signatureDate"`
+	CreatedAt        *time.Time     `json:"createdAt" bson:"createdAt"`
+	Links            MandateLinks   `json:"_links" bson:"_links"`
+}
+
+// MandateLinks represents the _links object returned in a Mandate
+// https://docs.mollie.com/reference/v2/mandates-api/get-mandate#response
+type MandateLinks struct {
+	Self          Link `json:"self" bson:"self"`
+	Customer      Link `json:"customer" bson:"customer"`
+	Documentation Link `json:"documentation" bson:"documentation"`
 }
 
 // MandateDetails is the payment method details for a customer mandate
-// https://www.mollie.com/en/docs/reference/mandates/get#response
+// https://docs.mollie.com/reference/v2/mandates-api/get-mandate#response
 type MandateDetails struct {
-	ConsumerName    string `json:"consumerName"`
-	ConsumerAccount string `json:"consumerAccount"`
-	ConsumerBic     string `json:"consumerBic"`
-	CardHolder      string `json:"cardHolder"`
-	CardNumber      string `json:"cardNumber"`
-	CardLabel       string `json:"cardLabel"`
-	CardFingerprint string `json:"cardFingerprint"`
-	CardExpiryDate  string `json:"cardExpiryDate"`
+	ConsumerName    string `json:"consumerName" bson:"consumerName"`
+	ConsumerAccount string `json:"consumerAccount" bson:"consumerAccount"`
+	ConsumerBic     string `json:"consumerBic" bson:"consumerBic"`
+	CardHolder      string `json:"cardHolder" bson:"cardHolder"`
+	CardNumber      string `json:"cardNumber" bson:"cardNumber"`
+	CardLabel       string `json:"cardLabel" bson:"cardLabel"`
+	CardFingerprint string `json:"cardFingerprint" bson:"cardFingerprint"`
+	CardExpiryDate  string `json:"cardExpiryDate" bson:"cardExpiryDate"`
+}
+
+// MandateRequest is a mandate create request
+// https://docs.mollie.com/reference/v2/mandates-api/create-mandate#parameters
+type MandateRequest struct {
+	Method                   string `json:"method" bson:"method"`
+	ConsumerName             string `json:"consumerName" bson:"consumerName"`
+	ConsumerAccount          string `json:"consumerAccount,omitempty" bson:"consumerAccount,omitempty"`
+	ConsumerBic              string `json:"consumerBic,omitempty" bson:"consumerBic,omitempty"`
+	ConsumerEmail            string `json:"consumerEmail,omitempty" bson:"consumerEmail,omitempty"`
+	SignatureDate            *Date  `json:"signatureDate,omitempty" bson:"signatureDate,omitempty"`
+	MandateReference         string `json:"mandateReference,omitempty" bson:"mandateReference,omitempty"`
+	PaypalBillingAgreementID string `json:"paypalBillingAgreementId,omitempty" bson:"paypalBillingAgreementId,omitempty"`
+}
+
+// IsUsable returns true if the mandate can be used for recurring payments.
+// Valid mandates are always usable, pending mandates only for SEPA direct
+// debit where the first payment is still being processed.
+func (m Mandate) IsUsable() bool {
+	switch m.Status {
+	case MandateStatusValid:
+		return true
+	case MandateStatusPending:
+		return m.Method == MethodDirectDebit
+	}
+	return false
 }
 
 // MandateService provides methods for accessing customer mandate records.
@@ -41,9 +85,9 @@ type MandateService struct {
 }
 
 // NewMandateService returns a new MandateService.
-func NewMandateService(accessToken string) *MandateService {
+func NewMandateService(accessToken string, opts ...ClientOption) *MandateService {
 	// Create mollie api client
-	client := NewClient(accessToken)
+	client := NewClient(accessToken, opts...)
 
 	return &MandateService{
 		sling: client,
@@ -51,44 +95,48 @@ func NewMandateService(accessToken string) *MandateService {
 }
 
 // MandateList is a list of customer mandate objects and list metadata
-// https://www.mollie.com/en/docs/reference/mandates/list#response
-type MandateList struct {
-	Data         []*Mandate `json:"data"`
-	ListMetadata `bson:",inline"`
+// https://docs.mollie.com/reference/v2/mandates-api/list-mandates#response
+type MandateList = List[*Mandate]
+
+// List returns a list of mandates for a customer
+func (s *MandateService) List(customerId string, params *ListParams, opts ...RequestOption) (MandateList, *http.Response, error) {
+	return doGet[MandateList](s.sling, fmt.Sprintf("customers/%s/mandates", customerId), params, opts...)
 }
 
-// MandateList returns a list of mandates for a customer
-func (s *MandateService) List(customerId string, params *ListParams) (MandateList, *http.Response, error) {
-	mandates := new(MandateList)
-	mollieError := new(MollieError)
-	resp, err := s.sling.New().Path(fmt.Sprintf("customers/%s/mandates", customerId)).QueryStruct(params).Receive(mandates, mollieError)
-	if err == nil && mollieError.Err.Type != "" {
-		err = mollieError
-	}
+// Create creates a new customer mandate
+func (s *MandateService) Create(customerId string, mandateBody *MandateRequest, opts ...RequestOption) (Mandate, *http.Response, error) {
+	return doPost[Mandate](s.sling, fmt.Sprintf("customers/%s/mandates", customerId), mandateBody, opts...)
+}
 
-	return *mandates, resp, err
+// Fetch returns a customer mandate
+func (s *MandateService) Fetch(customerId string, mandateId string, opts ...RequestOption) (Mandate, *http.Response, error) {
+	return doGet[Mandate](s.sling, fmt.Sprintf("customers/%s/mandates/%s", customerId, mandateId), nil, opts...)
 }
 
-// Mandate creates a new customer mandate
-func (s *MandateService) Create(customerId string, mandateBody PaymentRequest) (Mandate, *http.Response, error) {
-	mandate := new(Mandate)
-	mollieError := new(MollieError)
-	resp, err := s.sling.New().Post(fmt.Sprintf("customers/%s/mandates", customerId)).BodyJSON(mandateBody).Receive(mandate, mollieError)
-	if err == nil && mollieError.Err.Type != "" {
-		err = mollieError
+// Iter returns an iterator over all mandates for a customer, starting at params
+func (s *MandateService) Iter(customerId string, params *ListParams, opts ...RequestOption) *MandateIterator {
+	it := new(MandateIterator)
+	it.load = func(next string) (MandateList, error) {
+		if next == "" {
+			page, _, err := s.List(customerId, params, opts...)
+			return page, err
+		}
+		page, _, err := doGetNext[MandateList](s.sling, next, it.ctx, opts)
+		return page, err
 	}
 
-	return *mandate, resp, err
+	return it
 }
 
-// MandateFetch returns a customer mandate
-func (s *MandateService) Fetch(customerId string, mandateId string) (Mandate, *http.Response, error) {
-	mandate := new(Mandate)
-	mollieError := new(MollieError)
-	resp, err := s.sling.New().Path(fmt.Sprintf("customers/%s/mandates/%s", customerId, mandateId)).Receive(mandate, mollieError)
-	if err == nil && mollieError.Err.Type != "" {
-		err = mollieError
+// All returns all mandates for a customer, following all pages up to MaxAllPages
+func (s *MandateService) All(ctx context.Context, customerId string, params *ListParams, opts ...RequestOption) ([]*Mandate, error) {
+	it := s.Iter(customerId, params, contextOptions(ctx, opts)...)
+	it.limit(ctx, MaxAllPages)
+
+	var mandates []*Mandate
+	for it.Next() {
+		mandates = append(mandates, it.Mandate())
 	}
 
-	return *mandate, resp, err
+	return mandates, it.Err()
 }
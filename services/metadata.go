@@ -0,0 +1,108 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// maxMetadataSize is the maximum size of encoded metadata accepted by Mollie
+const maxMetadataSize = 1024
+
+// MetadataAs decodes resource metadata, such as Payment.Metadata, into a T.
+// Absent or null metadata decodes to the zero T.
+func MetadataAs[T any](metadata json.RawMessage) (T, error) {
+	var v T
+	if err := decodeRaw(metadata, &v); err != nil {
+		return v, fmt.Errorf("decoding metadata: %v", err)
+	}
+
+	return v, nil
+}
+
+// decodeRaw decodes the raw JSON of metadata or details into v, leaving v
+// unchanged if there is none
+func decodeRaw(data json.RawMessage, v interface{}) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+// DecodeMetadata decodes the payment metadata into v
+func (p Payment) DecodeMetadata(v interface{}) error {
+	return decodeRaw(p.Metadata, v)
+}
+
+// DecodeMetadata decodes the customer metadata into v
+func (c Customer) DecodeMetadata(v interface{}) error {
+	return decodeRaw(c.Metadata, v)
+}
+
+// DecodeMetadata decodes the refund metadata into v
+func (r Refund) DecodeMetadata(v interface{}) error {
+	return decodeRaw(r.Metadata, v)
+}
+
+// DecodeMetadata decodes the capture metadata into v
+func (c Capture) DecodeMetadata(v interface{}) error {
+	return decodeRaw(c.Metadata, v)
+}
+
+// encodeMetadata encodes v as metadata, checking it fits within the size
+// Mollie accepts
+func encodeMetadata(v interface{}) (json.RawMessage, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("encoding metadata: %v", err)
+	}
+	if len(data) > maxMetadataSize {
+		return nil, fmt.Errorf("metadata is %d bytes, the maximum is %d", len(data), maxMetadataSize)
+	}
+
+	return data, nil
+}
+
+// SetMetadata sets the payment metadata to v encoded as JSON
+func (r *PaymentRequest) SetMetadata(v interface{}) error {
+	metadata, err := encodeMetadata(v)
+	if err != nil {
+		return err
+	}
+	r.Metadata = metadata
+
+	return nil
+}
+
+// SetMetadata sets the customer metadata to v encoded as JSON
+func (r *CustomerRequest) SetMetadata(v interface{}) error {
+	metadata, err := encodeMetadata(v)
+	if err != nil {
+		return err
+	}
+	r.Metadata = metadata
+
+	return nil
+}
+
+// SetMetadata sets the refund metadata to v encoded as JSON
+func (r *PaymentRefundRequest) SetMetadata(v interface{}) error {
+	metadata, err := encodeMetadata(v)
+	if err != nil {
+		return err
+	}
+	r.Metadata = metadata
+
+	return nil
+}
+
+// SetMetadata sets the line metadata to v encoded as JSON
+func (l *PaymentLine) SetMetadata(v interface{}) error {
+	metadata, err := encodeMetadata(v)
+	if err != nil {
+		return err
+	}
+	l.Metadata = metadata
+
+	return nil
+}
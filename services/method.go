@@ -6,51 +6,103 @@ import (
 	"github.com/dghubble/sling"
 )
 
+// Payment method ids
+// https://docs.mollie.com/reference/v2/methods-api/list-methods
+const (
+	MethodApplePay       = "applepay"
+	MethodBancontact     = "bancontact"
+	MethodBankTransfer   = "banktransfer"
+	MethodBelfius        = "belfius"
+	MethodBillie         = "billie"
+	MethodCreditCard     = "creditcard"
+	MethodDirectDebit    = "directdebit"
+	MethodEPS            = "eps"
+	MethodGiftCard       = "giftcard"
+	MethodGiropay        = "giropay"
+	MethodIDEAL          = "ideal"
+	MethodKBC            = "kbc"
+	MethodKlarnaPayLater = "klarnapaylater"
+	MethodKlarnaSliceIt  = "klarnasliceit"
+	MethodPayPal         = "paypal"
+	MethodPaysafecard    = "paysafecard"
+	MethodPrzelewy24     = "przelewy24"
+	MethodSofort         = "sofort"
+	MethodVoucher        = "voucher"
+)
+
 // Method is a payment method type
-// https://www.mollie.com/nl/docs/reference/methods/get
+// https://docs.mollie.com/reference/v2/methods-api/get-method#response
 type Method struct {
-	ID          string `json:"id"`
-	Description string `json:"description"`
-	Image       struct {
-		Normal string `json:"normal"`
-		Bigger string `json:"bigger"`
-	} `json:"image"`
-	Amount struct {
-		Minimum string `json:"minimum"`
-		Maximum string `json:"maximum"`
-	} `json:"amount"`
+	Resource      string      `json:"resource" bson:"resource"`
+	ID            string      `json:"id" bson:"id"`
+	Description   string      `json:"description" bson:"description"`
+	MinimumAmount *Amount     `json:"minimumAmount" bson:"minimumAmount"`
+	MaximumAmount *Amount     `json:"maximumAmount" bson:"maximumAmount"`
+	Image         MethodImage `json:"image" bson:"image"`
+	Links         MethodLinks `json:"_links" bson:"_links"`
+}
+
+// MethodImage are the URLs of the method icon
+type MethodImage struct {
+	Size1x string `json:"size1x" bson:"size1x"`
+	Size2x string `json:"size2x" bson:"size2x"`
+	Svg    string `json:"svg" bson:"svg"`
+}
+
+// MethodLinks represents the _links object returned in a Method
+type MethodLinks struct {
+	Self          Link `json:"self" bson:"self"`
+	Documentation Link `json:"documentation" bson:"documentation"`
 }
 
 // MethodList is a list of method objects and list metadata
-// https://www.mollie.com/nl/docs/reference/methods/list#response
-type MethodList struct {
-	Data         []*Method `json:"data"`
-	ListMetadata `bson:",inline"`
+// https://docs.mollie.com/reference/v2/methods-api/list-methods#response
+type MethodList = List[*Method]
+
+// MethodListParams are the params for a method list request
+// https://docs.mollie.com/reference/v2/methods-api/list-methods#parameters
+type MethodListParams struct {
+	SequenceType   SequenceType `url:"sequenceType,omitempty"`
+	Locale         string       `url:"locale,omitempty"`
+	Amount         *Amount      `url:"amount,omitempty"`
+	Resource       string       `url:"resource,omitempty"`
+	BillingCountry string       `url:"billingCountry,omitempty"`
+	IncludeWallets string       `url:"includeWallets,omitempty"`
 }
 
 // MethodService provides methods for accessing payment methods.
 type MethodService struct {
 	sling *sling.Sling
+	cache *methodCache
 }
 
 // NewMethodService returns a new MethodService.
-func NewMethodService(accessToken string) *MethodService {
+func NewMethodService(accessToken string, opts ...ClientOption) *MethodService {
 	// Create mollie api client
-	client := NewClient(accessToken)
+	client := NewClient(accessToken, opts...)
 
 	return &MethodService{
 		sling: client,
+		cache: newMethodCache(newClientConfig(opts).methodCacheTTL),
 	}
 }
 
-// List returns the methods available for payments
-func (s *MethodService) List() (MethodList, *http.Response, error) {
-	methods := new(MethodList)
-	mollieError := new(MollieError)
-	resp, err := s.sling.New().Path("methods").Receive(methods, mollieError)
-	if err == nil && mollieError.Err.Type != "" {
-		err = mollieError
+// List returns the methods available for payments. When the method cache is
+// enabled with WithMethodCache, cached results are returned with a nil
+// response.
+func (s *MethodService) List(params *MethodListParams, opts ...RequestOption) (MethodList, *http.Response, error) {
+	key, err := s.cache.key(params, opts)
+	if err != nil {
+		return MethodList{}, nil, err
+	}
+	if methods, ok := s.cache.get(key); ok {
+		return methods, nil, nil
+	}
+
+	methods, resp, err := doGet[MethodList](s.sling, "methods", params, opts...)
+	if err == nil {
+		s.cache.set(key, methods)
 	}
 
-	return *methods, resp, err
+	return methods, resp, err
 }
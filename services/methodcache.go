@@ -0,0 +1,124 @@
+package services
+
+import (
+	"sync"
+	"time"
+
+	goquery "github.com/google/go-querystring/query"
+)
+
+// WithMethodCache caches method listings for ttl. Method availability rarely
+// changes, so checkout pages can list methods on every render without
+// calling Mollie each time.
+func WithMethodCache(ttl time.Duration) ClientOption {
+	return func(c *clientConfig) {
+		c.methodCacheTTL = ttl
+	}
+}
+
+// methodCache caches method listings by their request params. A nil cache
+// caches nothing.
+type methodCache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[string]methodCacheEntry
+}
+
+// methodCacheEntry is a cached method listing
+type methodCacheEntry struct {
+	methods MethodList
+	expires time.Time
+}
+
+// newMethodCache returns a cache for ttl, or nil if ttl is not positive
+func newMethodCache(ttl time.Duration) *methodCache {
+	if ttl <= 0 {
+		return nil
+	}
+
+	return &methodCache{
+		ttl:     ttl,
+		entries: make(map[string]methodCacheEntry),
+	}
+}
+
+// key returns the cache key of a listing with params and opts
+func (c *methodCache) key(params *MethodListParams, opts []RequestOption) (string, error) {
+	if c == nil {
+		return "", nil
+	}
+
+	values, err := goquery.Values(params)
+	if err != nil {
+		return "", err
+	}
+	options, err := goquery.Values(newRequestOptions(opts).query(false))
+	if err != nil {
+		return "", err
+	}
+
+	return values.Encode() + "&" + options.Encode(), nil
+}
+
+// get returns a copy of the cached listing for key, if it has not expired
+func (c *methodCache) get(key string) (MethodList, bool) {
+	if c == nil {
+		return MethodList{}, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expires) {
+		return MethodList{}, false
+	}
+
+	return copyMethods(entry.methods), true
+}
+
+// set caches a copy of the listing for key, dropping expired listings
+func (c *methodCache) set(key string, methods MethodList) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for k, entry := range c.entries {
+		if now.After(entry.expires) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = methodCacheEntry{
+		methods: copyMethods(methods),
+		expires: now.Add(c.ttl),
+	}
+}
+
+// copyMethods returns a deep copy of methods, so callers changing a listing
+// do not change the cached one
+func copyMethods(methods MethodList) MethodList {
+	items := make([]*Method, len(methods.Items))
+	for i, method := range methods.Items {
+		if method == nil {
+			continue
+		}
+		m := *method
+		if m.MinimumAmount != nil {
+			amount := *m.MinimumAmount
+			m.MinimumAmount = &amount
+		}
+		if m.MaximumAmount != nil {
+			amount := *m.MaximumAmount
+			m.MaximumAmount = &amount
+		}
+		items[i] = &m
+	}
+	methods.Items = items
+
+	return methods
+}
@@ -0,0 +1,49 @@
+package services
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestMethodCacheReturnsCopies(t *testing.T) {
+	methods := NewMethodService("test_x",
+		withTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, `{"count":1,"_embedded":{"methods":[
+				{"id":"ideal","description":"iDEAL","minimumAmount":{"currency":"EUR","value":"0.01"}}]}}`)
+		}),
+		WithMethodCache(time.Minute),
+	)
+
+	first, resp, err := methods.List(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp == nil {
+		t.Fatal("first listing was not fetched")
+	}
+
+	// Changing a listing must not change the cached one
+	first.Items[0].Description = "changed"
+	first.Items[0].MinimumAmount.Number = "99.00"
+	first.Items = append(first.Items[:0], &Method{ID: "extra"})
+
+	for i := 0; i < 2; i++ {
+		cached, resp, err := methods.List(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp != nil {
+			t.Fatal("listing was not cached")
+		}
+		if len(cached.Items) != 1 || cached.Items[0].ID != "ideal" {
+			t.Fatalf("got cached methods %v", cached.Items)
+		}
+		if method := cached.Items[0]; method.Description != "iDEAL" || method.MinimumAmount.Number != "0.01" {
+			t.Errorf("cached method changed to %+v, minimum %v", method, method.MinimumAmount)
+		}
+
+		cached.Items[0].Description = "changed again"
+		cached.Items[0].MinimumAmount.Number = "99.00"
+	}
+}
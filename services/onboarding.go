@@ -0,0 +1,98 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/dghubble/sling"
+)
+
+// Onboarding statuses
+// https://docs.mollie.com/reference/v2/onboarding-api/get-onboarding-status#response
+const (
+	OnboardingStatusNeedsData = "needs-data"
+	OnboardingStatusInReview  = "in-review"
+	OnboardingStatusCompleted = "completed"
+)
+
+// DefaultOnboardingPollInterval is the interval at which the onboarding
+// status is checked by WaitUntilCanReceivePayments
+const DefaultOnboardingPollInterval = time.Minute
+
+// Onboarding is the onboarding status of the current organization
+// https://docs.mollie.com/reference/v2/onboarding-api/get-onboarding-status#response
+type Onboarding struct {
+	Resource              string          `json:"resource" bson:"resource"`
+	Name                  string          `json:"name" bson:"name"`
+	SignedUpAt            *time.Time      `json:"signedUpAt" bson:"signedUpAt"`
+	Status                string          `json:"status" bson:"status"`
+	CanReceivePayments    bool            `json:"canReceivePayments" bson:"canReceivePayments"`
+	CanReceiveSettlements bool            `json:"canReceiveSettlements" bson:"canReceiveSettlements"`
+	Links                 OnboardingLinks `json:"_links" bson:"_links"`
+}
+
+// OnboardingLinks represents the _links object returned in an Onboarding
+type OnboardingLinks struct {
+	Self          Link `json:"self" bson:"self"`
+	Dashboard     Link `json:"dashboard" bson:"dashboard"`
+	Organization  Link `json:"organization" bson:"organization"`
+	Documentation Link `json:"documentation" bson:"documentation"`
+}
+
+// OnboardingService provides methods for checking the onboarding of an
+// organization, such as a merchant connected to a platform.
+type OnboardingService struct {
+	sling *sling.Sling
+}
+
+// NewOnboardingService returns a new OnboardingService.
+func NewOnboardingService(accessToken string, opts ...ClientOption) *OnboardingService {
+	// Create mollie api client
+	client := NewClient(accessToken, opts...)
+
+	return &OnboardingService{
+		sling: client,
+	}
+}
+
+// Status returns the onboarding status of the current organization
+func (s *OnboardingService) Status(opts ...RequestOption) (Onboarding, *http.Response, error) {
+	return doGet[Onboarding](s.sling, "onboarding/me", nil, opts...)
+}
+
+// WaitUntilCanReceivePayments polls the onboarding status every interval,
+// or DefaultOnboardingPollInterval if it is zero, until the organization can
+// receive payments, and returns that status. Onboarding can take days while
+// Mollie reviews the organization; bound the wait with ctx. Failed polls,
+// such as rate limits and 5xx responses, are retried at the next interval;
+// only 4xx errors, such as a revoked token, end the wait early.
+func (s *OnboardingService) WaitUntilCanReceivePayments(ctx context.Context, interval time.Duration, opts ...RequestOption) (Onboarding, error) {
+	if interval <= 0 {
+		interval = DefaultOnboardingPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	opts = contextOptions(ctx, opts)
+	for {
+		onboarding, _, err := s.Status(opts...)
+		if isClientError(err) || err == nil && onboarding.CanReceivePayments {
+			return onboarding, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return onboarding, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// isClientError returns true if err is a 4xx Mollie error, such as a missing
+// permission, which polling again does not fix
+func isClientError(err error) bool {
+	var mollieError *MollieError
+	return errors.As(err, &mollieError) && mollieError.Status >= 400 && mollieError.Status < 500
+}
@@ -0,0 +1,73 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestWaitUntilCanReceivePayments(t *testing.T) {
+	const (
+		inReview  = `{"resource":"onboarding","status":"in-review","canReceivePayments":false}`
+		completed = `{"resource":"onboarding","status":"completed","canReceivePayments":true}`
+	)
+	type response struct {
+		status int
+		body   string
+	}
+
+	tests := []struct {
+		name      string
+		responses []response
+		polls     int
+		status    int
+	}{
+		{"transient errors", []response{
+			{http.StatusServiceUnavailable, `<html>Service Unavailable</html>`},
+			{http.StatusInternalServerError, `{"status":500,"title":"Internal Server Error"}`},
+			{http.StatusTooManyRequests, `{"status":429,"title":"Too Many Requests"}`},
+			{http.StatusOK, inReview},
+			{http.StatusOK, completed},
+		}, 5, 0},
+		{"unauthorized", []response{
+			{http.StatusUnauthorized, `{"status":401,"title":"Unauthorized Request"}`},
+			{http.StatusOK, completed},
+		}, 1, http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			polls := 0
+			onboarding := NewOnboardingService("access_x", withTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				resp := tt.responses[polls]
+				polls++
+				if resp.status == http.StatusServiceUnavailable {
+					w.Header().Set("Content-Type", "text/html")
+					w.WriteHeader(resp.status)
+					w.Write([]byte(resp.body))
+					return
+				}
+				writeJSON(w, resp.status, resp.body)
+			}))
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			status, err := onboarding.WaitUntilCanReceivePayments(ctx, time.Millisecond)
+			if polls != tt.polls {
+				t.Errorf("got %d polls, want %d", polls, tt.polls)
+			}
+			if tt.status == 0 {
+				if err != nil || !status.CanReceivePayments {
+					t.Errorf("got %+v, %v, want a completed onboarding", status, err)
+				}
+				return
+			}
+			var mollieError *MollieError
+			if !errors.As(err, &mollieError) || mollieError.Status != tt.status {
+				t.Errorf("got error %v, want a %d MollieError", err, tt.status)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"time"
+
+	"github.com/dghubble/sling"
+)
+
+// RequestOption configures a single API request
+type RequestOption func(*requestOptions)
+
+// requestOptions are the options for a single API request
+type requestOptions struct {
+	Context        context.Context
+	Timeout        time.Duration
+	ProfileID      string
+	Testmode       bool
+	Include        []string
+	Embed          []string
+	IdempotencyKey string
+}
+
+// requestQuery is the query string for request options
+type requestQuery struct {
+	ProfileID string   `url:"profileId,omitempty"`
+	Testmode  bool     `url:"testmode,omitempty"`
+	Include   []string `url:"include,comma,omitempty"`
+	Embed     []string `url:"embed,comma,omitempty"`
+}
+
+// empty returns true if there is nothing to send in the query, so encoding
+// the query can be skipped
+func (q *requestQuery) empty() bool {
+	return q.ProfileID == "" && !q.Testmode && len(q.Include) == 0 && len(q.Embed) == 0
+}
+
+// without returns the query without the options already set in values
+func (q *requestQuery) without(values url.Values) *requestQuery {
+	r := *q
+	if values.Has("profileId") {
+		r.ProfileID = ""
+	}
+	if values.Has("testmode") {
+		r.Testmode = false
+	}
+	if values.Has("include") {
+		r.Include = nil
+	}
+	if values.Has("embed") {
+		r.Embed = nil
+	}
+
+	return &r
+}
+
+// WithContext sets the context of the request
+func WithContext(ctx context.Context) RequestOption {
+	return func(o *requestOptions) {
+		o.Context = ctx
+	}
+}
+
+// WithRequestTimeout overrides the client timeout for the request
+func WithRequestTimeout(timeout time.Duration) RequestOption {
+	return func(o *requestOptions) {
+		o.Timeout = timeout
+	}
+}
+
+// WithProfileID sets the website profile for the request, required when
+// using an organization access token. It is also how lists are filtered by
+// profile.
+func WithProfileID(profileID string) RequestOption {
+	return func(o *requestOptions) {
+		o.ProfileID = profileID
+	}
+}
+
+// WithTestmode makes the request in test mode, for organization access tokens
+func WithTestmode() RequestOption {
+	return func(o *requestOptions) {
+		o.Testmode = true
+	}
+}
+
+// WithInclude requests extra information to be included in the response
+func WithInclude(include ...string) RequestOption {
+	return func(o *requestOptions) {
+		o.Include = append(o.Include, include...)
+	}
+}
+
+// WithEmbed requests related collections to be embedded in the response,
+// eg. EmbedRefunds on a payment
+func WithEmbed(embed ...string) RequestOption {
+	return func(o *requestOptions) {
+		o.Embed = append(o.Embed, embed...)
+	}
+}
+
+// WithIdempotencyKey sets the idempotency key for the request, so a retried
+// create request does not create the resource twice
+func WithIdempotencyKey(key string) RequestOption {
+	return func(o *requestOptions) {
+		o.IdempotencyKey = key
+	}
+}
+
+// contextOptions returns opts with the request context set to ctx, unless
+// opts set it themselves
+func contextOptions(ctx context.Context, opts []RequestOption) []RequestOption {
+	return append([]RequestOption{WithContext(ctx)}, opts...)
+}
+
+// newRequestOptions returns the request options set by opts
+func newRequestOptions(opts []RequestOption) *requestOptions {
+	o := new(requestOptions)
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return o
+}
+
+// context returns the context of the request, carrying the request timeout
+// (if any) for the client to apply
+func (o *requestOptions) context() context.Context {
+	ctx := o.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if o.Timeout > 0 {
+		ctx = context.WithValue(ctx, timeoutKey{}, o.Timeout)
+	}
+
+	return ctx
+}
+
+// query returns the options sent in the query string. The profile and test
+// mode are sent in the body of requests that have one.
+func (o *requestOptions) query(withBody bool) *requestQuery {
+	q := &requestQuery{Include: o.Include, Embed: o.Embed}
+	if !withBody {
+		q.ProfileID = o.ProfileID
+		q.Testmode = o.Testmode
+	}
+
+	return q
+}
+
+// apply adds the options to the request built on s
+func (o *requestOptions) apply(s *sling.Sling, withBody bool) *sling.Sling {
+	if o.IdempotencyKey != "" {
+		s = s.Set("Idempotency-Key", o.IdempotencyKey)
+	}
+
+	if q := o.query(withBody); !q.empty() {
+		s = s.QueryStruct(q)
+	}
+
+	return s
+}
+
+// body returns body with the profile and test mode options added
+func (o *requestOptions) body(body interface{}) (interface{}, error) {
+	if o.ProfileID == "" && !o.Testmode {
+		return body, nil
+	}
+
+	fields := make(map[string]json.RawMessage)
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return nil, err
+		}
+	}
+	if o.ProfileID != "" {
+		fields["profileId"], _ = json.Marshal(o.ProfileID)
+	}
+	if o.Testmode {
+		fields["testmode"] = json.RawMessage("true")
+	}
+
+	return fields, nil
+}
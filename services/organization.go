@@ -0,0 +1,63 @@
+package services
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/dghubble/sling"
+)
+
+// Partner types
+// https://docs.mollie.com/reference/get-partner-status
+const (
+	PartnerTypeOAuth      = "oauth"
+	PartnerTypeSignupLink = "signuplink"
+	PartnerTypeUserAgent  = "useragent"
+)
+
+// Partner is the partner status of the current organization
+// https://docs.mollie.com/reference/get-partner-status
+type Partner struct {
+	Resource                       string           `json:"resource" bson:"resource"`
+	PartnerType                    string           `json:"partnerType" bson:"partnerType"`
+	IsCommissionPartner            bool             `json:"isCommissionPartner" bson:"isCommissionPartner"`
+	UserAgentTokens                []UserAgentToken `json:"userAgentTokens" bson:"userAgentTokens"`
+	PartnerContractSignedAt        *time.Time       `json:"partnerContractSignedAt" bson:"partnerContractSignedAt"`
+	PartnerContractUpdateAvailable bool             `json:"partnerContractUpdateAvailable" bson:"partnerContractUpdateAvailable"`
+	PartnerContractExpiresAt       *time.Time       `json:"partnerContractExpiresAt" bson:"partnerContractExpiresAt"`
+	Links                          PartnerLinks     `json:"_links" bson:"_links"`
+}
+
+// UserAgentToken is a token identifying a user agent partner
+type UserAgentToken struct {
+	Token    string     `json:"token" bson:"token"`
+	StartsAt *time.Time `json:"startsAt" bson:"startsAt"`
+	EndsAt   *time.Time `json:"endsAt" bson:"endsAt"`
+}
+
+// PartnerLinks represents the _links object returned in a Partner
+type PartnerLinks struct {
+	Self          Link `json:"self" bson:"self"`
+	SignupLink    Link `json:"signuplink" bson:"signuplink"`
+	Documentation Link `json:"documentation" bson:"documentation"`
+}
+
+// OrganizationService provides methods for accessing organization records.
+type OrganizationService struct {
+	sling *sling.Sling
+}
+
+// NewOrganizationService returns a new OrganizationService.
+func NewOrganizationService(accessToken string, opts ...ClientOption) *OrganizationService {
+	// Create mollie api client
+	client := NewClient(accessToken, opts...)
+
+	return &OrganizationService{
+		sling: client,
+	}
+}
+
+// Partner returns the partner status of the current organization
+func (s *OrganizationService) Partner(opts ...RequestOption) (Partner, *http.Response, error) {
+	return doGet[Partner](s.sling, "organizations/me/partner", nil, opts...)
+}
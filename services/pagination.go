@@ -0,0 +1,190 @@
+package services
+
+import (
+	"context"
+	"errors"
+)
+
+// MaxAllPages is the maximum number of pages fetched by the All list helpers
+// before they give up with ErrTooManyPages
+var MaxAllPages = 1000
+
+// ErrTooManyPages is returned when an All list helper reaches MaxAllPages
+var ErrTooManyPages = errors.New("list exceeds the maximum number of pages")
+
+// pageIterator walks the pages of a list resource by following the next link
+// returned in the list metadata. Typed iterators embed it and return the
+// current item. An iterator is not safe for concurrent use.
+type pageIterator[T any] struct {
+	load     func(next string) (List[T], error)
+	ctx      context.Context
+	maxPages int
+	prefetch bool
+	pending  chan pageResult[T]
+	pages    int
+	page     List[T]
+	next     string
+	done     bool
+	index    int
+	err      error
+}
+
+// pageResult is a page fetched in the background
+type pageResult[T any] struct {
+	page List[T]
+	err  error
+}
+
+// Next advances the iterator to the next item, fetching the next page when
+// the current one is exhausted. It returns false when there are no more
+// items or an error occurred.
+func (it *pageIterator[T]) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	it.index++
+	for it.index >= len(it.page.Items) {
+		if it.done {
+			return false
+		}
+		if it.ctx != nil && it.ctx.Err() != nil {
+			it.err = it.ctx.Err()
+			return false
+		}
+		if it.maxPages > 0 && it.pages >= it.maxPages {
+			it.err = ErrTooManyPages
+			return false
+		}
+		it.pages++
+
+		page, err := it.fetch()
+		if err != nil {
+			it.err = err
+			return false
+		}
+
+		it.page, it.index, it.next = page, 0, page.Links.Next.Href
+		it.done = it.next == ""
+		if it.prefetch && !it.done && (it.maxPages <= 0 || it.pages < it.maxPages) {
+			it.startPrefetch()
+		}
+	}
+
+	return true
+}
+
+// Err returns the error, if any, that stopped the iteration
+func (it *pageIterator[T]) Err() error {
+	return it.err
+}
+
+// Prefetch makes the iterator fetch the next page in the background while
+// the current page is consumed, hiding the latency of large listings. Call
+// it before the first call to Next.
+func (it *pageIterator[T]) Prefetch() {
+	it.prefetch = true
+}
+
+// Cursor returns the cursor of the current page. Resuming from it after a
+// crash repeats at most the items of the current page already seen.
+func (it *pageIterator[T]) Cursor() Cursor {
+	return CursorOf(it.page.Links.Self)
+}
+
+// fetch returns the next page, waiting for it if it is being prefetched
+func (it *pageIterator[T]) fetch() (List[T], error) {
+	if it.pending != nil {
+		result := <-it.pending
+		it.pending = nil
+		return result.page, result.err
+	}
+
+	return it.load(it.next)
+}
+
+// startPrefetch fetches the next page in the background
+func (it *pageIterator[T]) startPrefetch() {
+	pending := make(chan pageResult[T], 1)
+	go func(next string) {
+		page, err := it.load(next)
+		pending <- pageResult[T]{page: page, err: err}
+	}(it.next)
+	it.pending = pending
+}
+
+// limit stops the iteration when ctx is done or maxPages have been fetched
+func (it *pageIterator[T]) limit(ctx context.Context, maxPages int) {
+	it.ctx = ctx
+	it.maxPages = maxPages
+}
+
+// PaymentIterator iterates over payments across all pages
+type PaymentIterator struct {
+	pageIterator[*Payment]
+}
+
+// Payment returns the current payment
+func (it *PaymentIterator) Payment() *Payment {
+	return it.page.Items[it.index]
+}
+
+// PaymentRefundIterator iterates over payment refunds across all pages
+type PaymentRefundIterator struct {
+	pageIterator[*PaymentRefund]
+}
+
+// Refund returns the current payment refund
+func (it *PaymentRefundIterator) Refund() *PaymentRefund {
+	return it.page.Items[it.index]
+}
+
+// PaymentChargebackIterator iterates over payment chargebacks across all pages
+type PaymentChargebackIterator struct {
+	pageIterator[*PaymentChargeback]
+}
+
+// Chargeback returns the current payment chargeback
+func (it *PaymentChargebackIterator) Chargeback() *PaymentChargeback {
+	return it.page.Items[it.index]
+}
+
+// CustomerIterator iterates over customers across all pages
+type CustomerIterator struct {
+	pageIterator[*Customer]
+}
+
+// Customer returns the current customer
+func (it *CustomerIterator) Customer() *Customer {
+	return it.page.Items[it.index]
+}
+
+// MandateIterator iterates over customer mandates across all pages
+type MandateIterator struct {
+	pageIterator[*Mandate]
+}
+
+// Mandate returns the current mandate
+func (it *MandateIterator) Mandate() *Mandate {
+	return it.page.Items[it.index]
+}
+
+// SubscriptionIterator iterates over subscriptions across all pages
+type SubscriptionIterator struct {
+	pageIterator[*Subscription]
+}
+
+// Subscription returns the current subscription
+func (it *SubscriptionIterator) Subscription() *Subscription {
+	return it.page.Items[it.index]
+}
+
+// BalanceTransferIterator iterates over balance transfers across all pages
+type BalanceTransferIterator struct {
+	pageIterator[*BalanceTransfer]
+}
+
+// BalanceTransfer returns the current balance transfer
+func (it *BalanceTransferIterator) BalanceTransfer() *BalanceTransfer {
+	return it.page.Items[it.index]
+}
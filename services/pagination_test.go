@@ -0,0 +1,77 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// twoPages serves a payment list of two pages, calling onSecond before
+// answering the request for the second page
+func twoPages(t *testing.T, onSecond func(r *http.Request)) ClientOption {
+	return withTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("from") == "" {
+			writeJSON(w, http.StatusOK, `{"count":1,"_embedded":{"payments":[{"id":"tr_1"}]},"_links":{"next":{"href":"https://api.mollie.com/v2/payments?from=tr_2&testmode=true"}}}`)
+			return
+		}
+		onSecond(r)
+		writeJSON(w, http.StatusOK, `{"count":1,"_embedded":{"payments":[{"id":"tr_2"}]},"_links":{"next":null}}`)
+	})
+}
+
+func TestIterOptionsReachLaterPages(t *testing.T) {
+	var query string
+	s := NewPaymentService("test_x", twoPages(t, func(r *http.Request) {
+		query = r.URL.RawQuery
+	}))
+
+	it := s.Iter(nil, WithTestmode(), WithProfileID("pfl_1"))
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Payment().ID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 2 {
+		t.Fatalf("got payments %v, want 2", ids)
+	}
+	if want := "from=tr_2&profileId=pfl_1&testmode=true"; query != want {
+		t.Errorf("second page query %q, want %q", query, want)
+	}
+}
+
+func TestIterCancellationReachesLaterPages(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := NewPaymentService("test_x", twoPages(t, func(r *http.Request) {
+		cancel()
+		<-r.Context().Done()
+	}))
+
+	it := s.Iter(nil, WithContext(ctx))
+	for it.Next() {
+	}
+	if err := it.Err(); !errors.Is(err, context.Canceled) {
+		t.Errorf("got error %v, want context.Canceled", err)
+	}
+}
+
+func TestIterTimeoutReachesLaterPages(t *testing.T) {
+	s := NewPaymentService("test_x", twoPages(t, func(r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+
+	it := s.Iter(nil, WithRequestTimeout(50*time.Millisecond))
+	for it.Next() {
+	}
+	if err := it.Err(); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got error %v, want context.DeadlineExceeded", err)
+	}
+}
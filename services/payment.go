@@ -1,230 +1,400 @@
 package services
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/dghubble/sling"
-	"github.com/rollick/decimal"
 )
 
 // Payment is a payment object
-// https://www.mollie.com/nl/docs/reference/payments/get#response
+// https://docs.mollie.com/reference/v2/payments-api/get-payment#response
 type Payment struct {
-	ID                string          `json:"id"`
-	Resource          string          `json:"resource"`
-	Description       string          `json:"description"`
-	CreatedDatetime   *time.Time      `json:"createdDatetime"`
-	PaidDatetime      *time.Time      `json:"paidDatetime"`
-	CancelledDatetime *time.Time      `json:"cancelledDatetime"`
-	ExpiredDatetime   *time.Time      `json:"expiredDatetime"`
-	ExpiryPeriod      string          `json:"expiryPeriod"`
-	FailedDatetime    *time.Time      `json:"failedDatetime"`
-	Amount            decimal.Decimal `json:"amount"`
-	AmountRemaining   decimal.Decimal `json:"amountRemaining"`
-	AmountRefunded    decimal.Decimal `json:"amountRefunded"`
-	Mode              string          `json:"mode"`
-	Method            string          `json:"method"`
-	Status            string          `json:"status"`
-	Locale            string          `json:"locale"`
-	CountryCode       string          `json:"countryCode"`
-	ProfileID         string          `json:"profileId"`
-	CustomerID        string          `json:"customerId"`
-	MandateID         string          `json:"mandateId"`
-	SubscriptionID    string          `json:"subscriptionId"`
-	SettlementID      string          `json:"settlementId"`
-	RecurringType     string          `json:"recurringType"`
-	FailureReason     string          `json:"failureReason"`
-	ApplicationFee    ApplicationFee  `json:"applicationFee"`
-	Issuer            string          `json:"issuer"`
-	Metadata          interface{}     `json:"metadata"`
-	Details           interface{}     `json:"details"`
-	Links             PaymentLinks    `json:"links"`
+	Resource          string           `json:"resource" bson:"resource"`
+	ID                string           `json:"id" bson:"id"`
+	Mode              string           `json:"mode" bson:"mode"`
+	CreatedAt         *time.Time       `json:"createdAt" bson:"createdAt"`
+	Status            string           `json:"status" bson:"status"`
+	IsCancelable      bool             `json:"isCancelable" bson:"isCancelable"`
+	AuthorizedAt      *time.Time       `json:"authorizedAt" bson:"authorizedAt"`
+	PaidAt            *time.Time       `json:"paidAt" bson:"paidAt"`
+	CanceledAt        *time.Time       `json:"canceledAt" bson:"canceledAt"`
+	ExpiresAt         *time.Time       `json:"expiresAt" bson:"expiresAt"`
+	ExpiredAt         *time.Time       `json:"expiredAt" bson:"expiredAt"`
+	FailedAt          *time.Time       `json:"failedAt" bson:"failedAt"`
+	Amount            Amount           `json:"amount" bson:"amount"`
+	AmountRefunded    *Amount          `json:"amountRefunded" bson:"amountRefunded"`
+	AmountRemaining   *Amount          `json:"amountRemaining" bson:"amountRemaining"`
+	AmountCaptured    *Amount          `json:"amountCaptured" bson:"amountCaptured"`
+	AmountChargedBack *Amount          `json:"amountChargedBack" bson:"amountChargedBack"`
+	SettlementAmount  *Amount          `json:"settlementAmount" bson:"settlementAmount"`
+	Description       string           `json:"description" bson:"description"`
+	RedirectUrl       string           `json:"redirectUrl" bson:"redirectUrl"`
+	CancelUrl         string           `json:"cancelUrl" bson:"cancelUrl"`
+	WebhookUrl        string           `json:"webhookUrl" bson:"webhookUrl"`
+	Method            string           `json:"method" bson:"method"`
+	Metadata          json.RawMessage  `json:"metadata" bson:"metadata"`
+	Lines             []PaymentLine    `json:"lines,omitempty" bson:"lines,omitempty"`
+	Locale            string           `json:"locale" bson:"locale"`
+	CountryCode       string           `json:"countryCode" bson:"countryCode"`
+	ProfileID         string           `json:"profileId" bson:"profileId"`
+	SettlementID      string           `json:"settlementId" bson:"settlementId"`
+	CustomerID        string           `json:"customerId" bson:"customerId"`
+	SequenceType      SequenceType     `json:"sequenceType" bson:"sequenceType"`
+	MandateID         string           `json:"mandateId" bson:"mandateId"`
+	SubscriptionID    string           `json:"subscriptionId" bson:"subscriptionId"`
+	OrderID           string           `json:"orderId" bson:"orderId"`
+	ApplicationFee    ApplicationFee   `json:"applicationFee" bson:"applicationFee"`
+	Details           json.RawMessage  `json:"details" bson:"details"`
+	Embedded          *PaymentEmbedded `json:"_embedded,omitempty" bson:"_embedded,omitempty"`
+	Links             PaymentLinks     `json:"_links" bson:"_links"`
 }
 
 // ApplicationFee is the application fee, if the payment was created with one.
 type ApplicationFee struct {
-	Amount      decimal.Decimal `json:"amount"`
-	Description string          `json:"description"`
+	Amount      Amount `json:"amount" bson:"amount"`
+	Description string `json:"description" bson:"description"`
 }
 
-// PaymentLinks respresents the links object returned in a Payment
-// https://www.mollie.com/en/docs/reference/payments/get#response
+// MarshalJSON encodes the application fee, or null for a payment without one
+func (f ApplicationFee) MarshalJSON() ([]byte, error) {
+	if f == (ApplicationFee{}) {
+		return []byte("null"), nil
+	}
+
+	type applicationFee ApplicationFee
+	return json.Marshal(applicationFee(f))
+}
+
+// PaymentLinks represents the _links object returned in a Payment
+// https://docs.mollie.com/reference/v2/payments-api/get-payment#response
 type PaymentLinks struct {
-	PaymentUrl  string `json:"paymentUrl"`
-	WebhookUrl  string `json:"webhookUrl"`
-	RedirectUrl string `json:"redirectUrl"`
-	Settlement  string `json:"settlement"`
-	Refunds     string `json:"refunds"`
+	Self          Link `json:"self" bson:"self"`
+	Checkout      Link `json:"checkout" bson:"checkout"`
+	Dashboard     Link `json:"dashboard" bson:"dashboard"`
+	Refunds       Link `json:"refunds" bson:"refunds"`
+	Chargebacks   Link `json:"chargebacks" bson:"chargebacks"`
+	Captures      Link `json:"captures" bson:"captures"`
+	Settlement    Link `json:"settlement" bson:"settlement"`
+	Mandate       Link `json:"mandate" bson:"mandate"`
+	Subscription  Link `json:"subscription" bson:"subscription"`
+	Customer      Link `json:"customer" bson:"customer"`
+	Order         Link `json:"order" bson:"order"`
+	Documentation Link `json:"documentation" bson:"documentation"`
+}
+
+// String summarizes the payment for logging, leaving out the metadata and
+// customer details
+func (p Payment) String() string {
+	return fmt.Sprintf("payment %s (%s, %s)", p.ID, p.Status, p.Amount)
+}
+
+// CheckoutURL returns the URL to redirect the customer to for completing the
+// payment, or "" if the payment has no checkout (anymore)
+func (p Payment) CheckoutURL() string {
+	return p.Links.Checkout.Href
+}
+
+// DashboardURL returns the URL of the payment in the Mollie dashboard
+func (p Payment) DashboardURL() string {
+	return p.Links.Dashboard.Href
+}
+
+// PaymentEmbedded holds the collections embedded in a payment fetched or
+// listed with WithEmbed
+type PaymentEmbedded struct {
+	Refunds     []*Refund     `json:"refunds,omitempty" bson:"refunds,omitempty"`
+	Chargebacks []*Chargeback `json:"chargebacks,omitempty" bson:"chargebacks,omitempty"`
+	Captures    []*Capture    `json:"captures,omitempty" bson:"captures,omitempty"`
 }
 
 // PaymentList is a list of payment objects and list metadata
 // https://www.mollie.com/nl/docs/reference/payments/list#response
-type PaymentList struct {
-	Data         []*Payment `json:"data"`
-	ListMetadata `bson:",inline"`
-}
+type PaymentList = List[*Payment]
 
 // PaymentRequest is a payment request
-// https://www.mollie.com/nl/docs/reference/payments/create
+// https://docs.mollie.com/reference/v2/payments-api/create-payment
 type PaymentRequest struct {
-	Amount        decimal.Decimal `json:"amount,omitempty"`
-	Description   string          `json:"description,omitempty"`
-	RedirectUrl   string          `json:"redirectUrl,omitempty"`
-	WebhookUrl    string          `json:"webhookUrl,omitempty"`
-	Method        string          `json:"method,omitempty"`
-	Locale        string          `json:"locale,omitempty"`
-	RecurringType string          `json:"recurringType,omitempty"`
-	CustomerID    string          `json:"customerId,omitempty"`
-	MandateID     string          `json:"mandateId,omitempty"`
-	Metadata      interface{}     `json:"metadata,omitempty"`
+	Amount       Amount          `json:"amount" bson:"amount"`
+	Description  string          `json:"description,omitempty" bson:"description,omitempty"`
+	RedirectUrl  string          `json:"redirectUrl,omitempty" bson:"redirectUrl,omitempty"`
+	CancelUrl    string          `json:"cancelUrl,omitempty" bson:"cancelUrl,omitempty"`
+	WebhookUrl   string          `json:"webhookUrl,omitempty" bson:"webhookUrl,omitempty"`
+	Method       string          `json:"method,omitempty" bson:"method,omitempty"`
+	Locale       string          `json:"locale,omitempty" bson:"locale,omitempty"`
+	SequenceType SequenceType    `json:"sequenceType,omitempty" bson:"sequenceType,omitempty"`
+	CustomerID   string          `json:"customerId,omitempty" bson:"customerId,omitempty"`
+	MandateID    string          `json:"mandateId,omitempty" bson:"mandateId,omitempty"`
+	Metadata     json.RawMessage `json:"metadata,omitempty" bson:"metadata,omitempty"`
+	Lines        []PaymentLine   `json:"lines,omitempty" bson:"lines,omitempty"`
+
+	// Addresses used for PayPal seller protection and risk checks
+	BillingAddress  *Address `json:"billingAddress,omitempty" bson:"billingAddress,omitempty"`
+	ShippingAddress *Address `json:"shippingAddress,omitempty" bson:"shippingAddress,omitempty"`
+
+	// Company of a business customer, for B2B methods such as Billie
+	Company *Company `json:"company,omitempty" bson:"company,omitempty"`
+
+	// Method specific parameters
+	// https://docs.mollie.com/reference/v2/payments-api/create-payment#payment-method-specific-parameters
+	ApplePayPaymentToken string `json:"applePayPaymentToken,omitempty" bson:"applePayPaymentToken,omitempty"`
+	BillingEmail         string `json:"billingEmail,omitempty" bson:"billingEmail,omitempty"`
+	DueDate              *Date  `json:"dueDate,omitempty" bson:"dueDate,omitempty"`
+	CardToken            string `json:"cardToken,omitempty" bson:"cardToken,omitempty"`
+	Issuer               string `json:"issuer,omitempty" bson:"issuer,omitempty"`
+	ConsumerName         string `json:"consumerName,omitempty" bson:"consumerName,omitempty"`
+	ConsumerAccount      string `json:"consumerAccount,omitempty" bson:"consumerAccount,omitempty"`
+	VoucherNumber        string `json:"voucherNumber,omitempty" bson:"voucherNumber,omitempty"`
+	VoucherPin           string `json:"voucherPin,omitempty" bson:"voucherPin,omitempty"`
+	CustomerReference    string `json:"customerReference,omitempty" bson:"customerReference,omitempty"`
+	SessionID            string `json:"sessionId,omitempty" bson:"sessionId,omitempty"`
 }
 
-// PaymentRefund is a payment refund response
-// https://www.mollie.com/en/docs/reference/refunds/get#response
-type PaymentRefund struct {
-	ID             string          `json:"id"`
-	Payment        Payment         `json:"payment"`
-	Amount         decimal.Decimal `json:"amount"`
-	Status         string          `json:"status"`
-	RefundDatetime *time.Time      `json:"refundDatetime"`
+// PaymentLine is a line of the products or services paid for
+// https://docs.mollie.com/reference/v2/payments-api/create-payment#lines
+type PaymentLine struct {
+	Type           LineType        `json:"type,omitempty" bson:"type,omitempty"`
+	Description    string          `json:"description" bson:"description"`
+	Quantity       int             `json:"quantity" bson:"quantity"`
+	QuantityUnit   string          `json:"quantityUnit,omitempty" bson:"quantityUnit,omitempty"`
+	UnitPrice      Amount          `json:"unitPrice" bson:"unitPrice"`
+	DiscountAmount *Amount         `json:"discountAmount,omitempty" bson:"discountAmount,omitempty"`
+	TotalAmount    Amount          `json:"totalAmount" bson:"totalAmount"`
+	VatRate        string          `json:"vatRate,omitempty" bson:"vatRate,omitempty"`
+	VatAmount      *Amount         `json:"vatAmount,omitempty" bson:"vatAmount,omitempty"`
+	SKU            string          `json:"sku,omitempty" bson:"sku,omitempty"`
+	Categories     []LineCategory  `json:"categories,omitempty" bson:"categories,omitempty"`
+	ImageUrl       string          `json:"imageUrl,omitempty" bson:"imageUrl,omitempty"`
+	ProductUrl     string          `json:"productUrl,omitempty" bson:"productUrl,omitempty"`
+	Metadata       json.RawMessage `json:"metadata,omitempty" bson:"metadata,omitempty"`
 }
 
+// Refund and chargeback list embeds, requested with WithEmbed
+const (
+	EmbedPayment = "payment"
+)
+
+// Payment fetch and list embeds, requested with WithEmbed
+const (
+	EmbedRefunds     = "refunds"
+	EmbedChargebacks = "chargebacks"
+	EmbedCaptures    = "captures"
+)
+
+// Payment fetch includes, requested with WithInclude
+const (
+	IncludeRemainderDetails = "details.remainderDetails"
+)
+
+// PaymentRefund is a payment refund response
+type PaymentRefund = Refund
+
 // PaymentRefundRequest is a payment refund request
-// https://www.mollie.com/en/docs/reference/refunds/create
+// https://docs.mollie.com/reference/v2/refunds-api/create-refund#parameters
 type PaymentRefundRequest struct {
-	Amount      decimal.Decimal `json:"amount,omitempty"`
-	Description string          `json:"description,omitempty"`
+	Amount      *Amount         `json:"amount,omitempty" bson:"amount,omitempty"`
+	Description string          `json:"description,omitempty" bson:"description,omitempty"`
+	Metadata    json.RawMessage `json:"metadata,omitempty" bson:"metadata,omitempty"`
+
+	// CaptureID scopes the refund to a capture of the payment
+	CaptureID string `json:"captureId,omitempty" bson:"captureId,omitempty"`
+
+	// ReverseRouting reverses the split of a marketplace payment, pulling
+	// the routed funds back from the connected accounts
+	ReverseRouting bool `json:"reverseRouting,omitempty" bson:"reverseRouting,omitempty"`
 }
 
 // PaymentRefundList is a list of payment refund objects and list metadata
-// https://www.mollie.com/en/docs/reference/refunds/list#response
-type PaymentRefundList struct {
-	Data         []*PaymentRefund `json:"data"`
-	ListMetadata `bson:",inline"`
-}
+// https://docs.mollie.com/reference/v2/refunds-api/list-refunds#response
+type PaymentRefundList = List[*PaymentRefund]
 
 // PaymentChargeback is a payment chargeback response
-// https://www.mollie.com/en/docs/reference/chargebacks/get#response
-type PaymentChargeback struct {
-	ID                 string          `json:"id"`
-	Payment            Payment         `json:"payment"`
-	Amount             decimal.Decimal `json:"amount"`
-	Status             string          `json:"status"`
-	ChargebackDatetime *time.Time      `json:"chargebackDatetime"`
-	ReversedDatetime   *time.Time      `json:"reversedDatetime"`
-}
+type PaymentChargeback = Chargeback
 
 // PaymentChargebackList is a list of payment chargeback objects and list metadata
-// https://www.mollie.com/en/docs/reference/chargebacks/list#response
-type PaymentChargebackList struct {
-	Data         []*PaymentChargeback `json:"data"`
-	ListMetadata `bson:",inline"`
-}
+// https://docs.mollie.com/reference/v2/chargebacks-api/list-chargebacks#response
+type PaymentChargebackList = List[*PaymentChargeback]
 
 // PaymentService provides methods for creating and reading payments
 type PaymentService struct {
-	sling *sling.Sling
+	sling    *sling.Sling
+	doer     sling.Doer
+	defaults PaymentDefaults
 }
 
 // NewPaymentService returns a new PaymentService
-func NewPaymentService(accessToken string) *PaymentService {
-	// Create mollie api client
-	client := NewClient(accessToken)
+func NewPaymentService(accessToken string, opts ...ClientOption) *PaymentService {
+	config := newClientConfig(opts)
+	doer := config.doer()
 
 	return &PaymentService{
-		sling: client,
+		sling:    newClient(accessToken, config, doer),
+		doer:     doer,
+		defaults: config.paymentDefaults,
 	}
 }
 
 // List returns the accessible payments
-func (s *PaymentService) List(params *ListParams) (PaymentList, *http.Response, error) {
-	payments := new(PaymentList)
-	mollieError := new(MollieError)
-	resp, err := s.sling.New().Path("payments").QueryStruct(params).Receive(payments, mollieError)
-	if err == nil && mollieError.Err.Type != "" {
-		err = mollieError
-	}
-
-	return *payments, resp, err
+func (s *PaymentService) List(params *ListParams, opts ...RequestOption) (PaymentList, *http.Response, error) {
+	return doGet[PaymentList](s.sling, "payments", params, opts...)
 }
 
-// Fetch returns an existing payment
-func (s *PaymentService) Fetch(paymentId string) (Payment, *http.Response, error) {
-	payment := new(Payment)
-	mollieError := new(MollieError)
-	resp, err := s.sling.New().Get(fmt.Sprintf("payments/%s", paymentId)).Receive(payment, mollieError)
-	if err == nil && mollieError.Err.Type != "" {
-		err = mollieError
-	}
-	return *payment, resp, err
+// Fetch returns an existing payment. Embedded collections and extra details
+// are requested with WithEmbed and WithInclude.
+func (s *PaymentService) Fetch(paymentId string, opts ...RequestOption) (Payment, *http.Response, error) {
+	return doGet[Payment](s.sling, fmt.Sprintf("payments/%s", paymentId), nil, opts...)
 }
 
 // Create creates a new payment
-func (s *PaymentService) Create(paymentBody *PaymentRequest) (Payment, *http.Response, error) {
-	payment := new(Payment)
-	mollieError := new(MollieError)
-	resp, err := s.sling.New().Post("payments").BodyJSON(paymentBody).Receive(payment, mollieError)
-	if err == nil && mollieError.Err.Type != "" {
-		err = mollieError
+func (s *PaymentService) Create(paymentBody *PaymentRequest, opts ...RequestOption) (Payment, *http.Response, error) {
+	if paymentBody == nil {
+		return Payment{}, nil, errRequiredBody
 	}
-	return *payment, resp, err
+	body := s.defaults.apply(*paymentBody)
+	if err := body.Validate(); err != nil {
+		return Payment{}, nil, err
+	}
+
+	return doPost[Payment](s.sling, "payments", &body, opts...)
 }
 
 // CreateRefund creates a new payment refund
-func (s *PaymentService) CreateRefund(paymentId string, refundBody *PaymentRefundRequest) (PaymentRefund, *http.Response, error) {
-	refund := new(PaymentRefund)
-	mollieError := new(MollieError)
-	resp, err := s.sling.New().Post(fmt.Sprintf("payments/%s/refunds", paymentId)).BodyJSON(refundBody).Receive(refund, mollieError)
-	if err == nil && mollieError.Err.Type != "" {
-		err = mollieError
+func (s *PaymentService) CreateRefund(paymentId string, refundBody *PaymentRefundRequest, opts ...RequestOption) (PaymentRefund, *http.Response, error) {
+	if refundBody == nil {
+		return PaymentRefund{}, nil, errRequiredBody
 	}
-	return *refund, resp, err
+	if refundBody.Amount != nil {
+		if err := refundBody.Amount.Validate(); err != nil {
+			return PaymentRefund{}, nil, err
+		}
+	}
+
+	return doPost[PaymentRefund](s.sling, fmt.Sprintf("payments/%s/refunds", paymentId), refundBody, opts...)
 }
 
-// FetchRefund returns a payment refund
-func (s *PaymentService) FetchRefund(paymentId string, refundId string) (PaymentRefund, *http.Response, error) {
-	refund := new(PaymentRefund)
-	mollieError := new(MollieError)
-	resp, err := s.sling.New().Get(fmt.Sprintf("payments/%s/refunds/%s", paymentId, refundId)).Receive(refund, mollieError)
-	if err == nil && mollieError.Err.Type != "" {
-		err = mollieError
+// CreateCaptureRefund creates a refund of a capture of a payment, eg. for the
+// settlement of a captured Klarna payment
+func (s *PaymentService) CreateCaptureRefund(paymentId string, captureId string, refundBody *PaymentRefundRequest, opts ...RequestOption) (PaymentRefund, *http.Response, error) {
+	if refundBody == nil {
+		return PaymentRefund{}, nil, errRequiredBody
 	}
-	return *refund, resp, err
+	scoped := *refundBody
+	scoped.CaptureID = captureId
+
+	return s.CreateRefund(paymentId, &scoped, opts...)
+}
+
+// FetchRefund returns a payment refund
+func (s *PaymentService) FetchRefund(paymentId string, refundId string, opts ...RequestOption) (PaymentRefund, *http.Response, error) {
+	return doGet[PaymentRefund](s.sling, fmt.Sprintf("payments/%s/refunds/%s", paymentId, refundId), nil, opts...)
 }
 
 // RefundList returns all payment refunds created
-func (s *PaymentService) RefundList(paymentId string, params *ListParams) (PaymentRefundList, *http.Response, error) {
-	refunds := new(PaymentRefundList)
-	mollieError := new(MollieError)
-	resp, err := s.sling.New().Path(fmt.Sprintf("payments/%s/refunds", paymentId)).QueryStruct(params).Receive(refunds, mollieError)
-	if err == nil && mollieError.Err.Type != "" {
-		err = mollieError
+func (s *PaymentService) RefundList(paymentId string, params *ListParams, opts ...RequestOption) (PaymentRefundList, *http.Response, error) {
+	return doGet[PaymentRefundList](s.sling, fmt.Sprintf("payments/%s/refunds", paymentId), params, opts...)
+}
+
+// FetchChargeback returns a payment chargeback
+func (s *PaymentService) FetchChargeback(paymentId string, chargebackId string, opts ...RequestOption) (PaymentChargeback, *http.Response, error) {
+	return doGet[PaymentChargeback](s.sling, fmt.Sprintf("payments/%s/chargebacks/%s", paymentId, chargebackId), nil, opts...)
+}
+
+// ChargebackList returns all payment chargebacks created
+func (s *PaymentService) ChargebackList(paymentId string, params *ListParams, opts ...RequestOption) (PaymentChargebackList, *http.Response, error) {
+	return doGet[PaymentChargebackList](s.sling, fmt.Sprintf("payments/%s/chargebacks", paymentId), params, opts...)
+}
+
+// Iter returns an iterator over all accessible payments, starting at params
+func (s *PaymentService) Iter(params *ListParams, opts ...RequestOption) *PaymentIterator {
+	it := new(PaymentIterator)
+	it.load = func(next string) (PaymentList, error) {
+		if next == "" {
+			page, _, err := s.List(params, opts...)
+			return page, err
+		}
+		page, _, err := doGetNext[PaymentList](s.sling, next, it.ctx, opts)
+		return page, err
 	}
 
-	return *refunds, resp, err
+	return it
 }
 
-// FetchChargeback returns a payment chargeback
-func (s *PaymentService) FetchChargeback(paymentId string, chargebackId string) (PaymentChargeback, *http.Response, error) {
-	chargeback := new(PaymentChargeback)
-	mollieError := new(MollieError)
-	resp, err := s.sling.New().Get(fmt.Sprintf("payments/%s/chargebacks/%s", paymentId, chargebackId)).Receive(chargeback, mollieError)
-	if err == nil && mollieError.Err.Type != "" {
-		err = mollieError
+// RefundIter returns an iterator over all payment refunds, starting at params
+func (s *PaymentService) RefundIter(paymentId string, params *ListParams, opts ...RequestOption) *PaymentRefundIterator {
+	it := new(PaymentRefundIterator)
+	it.load = func(next string) (PaymentRefundList, error) {
+		if next == "" {
+			page, _, err := s.RefundList(paymentId, params, opts...)
+			return page, err
+		}
+		page, _, err := doGetNext[PaymentRefundList](s.sling, next, it.ctx, opts)
+		return page, err
 	}
-	return *chargeback, resp, err
+
+	return it
 }
 
-// ChargebackList returns all payment chargebacks created
-func (s *PaymentService) ChargebackList(paymentId string, params *ListParams) (PaymentChargebackList, *http.Response, error) {
-	chargebacks := new(PaymentChargebackList)
-	mollieError := new(MollieError)
-	resp, err := s.sling.New().Path(fmt.Sprintf("payments/%s/chargebacks", paymentId)).QueryStruct(params).Receive(chargebacks, mollieError)
-	if err == nil && mollieError.Err.Type != "" {
-		err = mollieError
+// ChargebackIter returns an iterator over all payment chargebacks, starting at params
+func (s *PaymentService) ChargebackIter(paymentId string, params *ListParams, opts ...RequestOption) *PaymentChargebackIterator {
+	it := new(PaymentChargebackIterator)
+	it.load = func(next string) (PaymentChargebackList, error) {
+		if next == "" {
+			page, _, err := s.ChargebackList(paymentId, params, opts...)
+			return page, err
+		}
+		page, _, err := doGetNext[PaymentChargebackList](s.sling, next, it.ctx, opts)
+		return page, err
+	}
+
+	return it
+}
+
+// All returns all accessible payments, following all pages up to MaxAllPages
+func (s *PaymentService) All(ctx context.Context, params *ListParams, opts ...RequestOption) ([]*Payment, error) {
+	it := s.Iter(params, contextOptions(ctx, opts)...)
+	it.limit(ctx, MaxAllPages)
+
+	var payments []*Payment
+	for it.Next() {
+		payments = append(payments, it.Payment())
+	}
+
+	return payments, it.Err()
+}
+
+// Stream calls fn for each accessible payment, starting at params and
+// following all pages, decoding the payments one at a time from the response.
+// It uses less memory than All or Iter for large pages. An error returned by
+// fn stops the stream and is returned.
+func (s *PaymentService) Stream(ctx context.Context, params *ListParams, fn func(*Payment) error, opts ...RequestOption) error {
+	return streamList(ctx, s.sling, s.doer, "payments", params, fn, opts)
+}
+
+// AllRefunds returns all payment refunds, following all pages up to MaxAllPages
+func (s *PaymentService) AllRefunds(ctx context.Context, paymentId string, params *ListParams, opts ...RequestOption) ([]*PaymentRefund, error) {
+	it := s.RefundIter(paymentId, params, contextOptions(ctx, opts)...)
+	it.limit(ctx, MaxAllPages)
+
+	var refunds []*PaymentRefund
+	for it.Next() {
+		refunds = append(refunds, it.Refund())
+	}
+
+	return refunds, it.Err()
+}
+
+// AllChargebacks returns all payment chargebacks, following all pages up to MaxAllPages
+func (s *PaymentService) AllChargebacks(ctx context.Context, paymentId string, params *ListParams, opts ...RequestOption) ([]*PaymentChargeback, error) {
+	it := s.ChargebackIter(paymentId, params, contextOptions(ctx, opts)...)
+	it.limit(ctx, MaxAllPages)
+
+	var chargebacks []*PaymentChargeback
+	for it.Next() {
+		chargebacks = append(chargebacks, it.Chargeback())
 	}
 
-	return *chargebacks, resp, err
+	return chargebacks, it.Err()
 }
@@ -0,0 +1,118 @@
+package services
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestPaymentEmbedAndInclude(t *testing.T) {
+	tests := []struct {
+		name  string
+		call  func(s *PaymentService) error
+		path  string
+		query string
+	}{
+		{"Fetch", func(s *PaymentService) error {
+			_, _, err := s.Fetch("tr_1", WithEmbed(EmbedRefunds, EmbedChargebacks), WithInclude(IncludeRemainderDetails))
+			return err
+		}, "/v2/payments/tr_1", "embed=refunds%2Cchargebacks&include=details.remainderDetails"},
+		{"List", func(s *PaymentService) error {
+			_, _, err := s.List(&ListParams{Limit: 5}, WithEmbed(EmbedCaptures))
+			return err
+		}, "/v2/payments", "embed=captures&limit=5"},
+		{"List with profile", func(s *PaymentService) error {
+			_, _, err := s.List(&ListParams{Limit: 5}, WithProfileID("pfl_QkEhN94Ba"))
+			return err
+		}, "/v2/payments", "limit=5&profileId=pfl_QkEhN94Ba"},
+		{"RefundList", func(s *PaymentService) error {
+			_, _, err := s.RefundList("tr_1", nil, WithEmbed(EmbedPayment))
+			return err
+		}, "/v2/payments/tr_1/refunds", "embed=payment"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payments := NewPaymentService("test_x", withTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != tt.path || r.URL.RawQuery != tt.query {
+					t.Errorf("got %s?%s, want %s?%s", r.URL.Path, r.URL.RawQuery, tt.path, tt.query)
+				}
+				writeJSON(w, http.StatusOK, `{"id":"tr_1","_embedded":{"refunds":[{"id":"re_1"}]}}`)
+			}))
+			if err := tt.call(payments); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestPaymentEmbedded(t *testing.T) {
+	payments := NewPaymentService("test_x", withTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, `{"id":"tr_1","_embedded":{"refunds":[{"id":"re_1"}],"captures":[{"id":"cpt_1"}]}}`)
+	}))
+
+	payment, _, err := payments.Fetch("tr_1", WithEmbed(EmbedRefunds, EmbedCaptures))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if payment.Embedded == nil {
+		t.Fatal("no embedded collections decoded")
+	}
+	if len(payment.Embedded.Refunds) != 1 || payment.Embedded.Refunds[0].ID != "re_1" {
+		t.Errorf("got refunds %v", payment.Embedded.Refunds)
+	}
+	if len(payment.Embedded.Captures) != 1 || payment.Embedded.Captures[0].ID != "cpt_1" {
+		t.Errorf("got captures %v", payment.Embedded.Captures)
+	}
+	if payment.Embedded.Chargebacks != nil {
+		t.Errorf("got chargebacks %v, want none", payment.Embedded.Chargebacks)
+	}
+}
+
+// cannedTransport answers every request with body, without a network round
+// trip, so benchmarks measure the request layer alone
+type cannedTransport struct {
+	body string
+}
+
+// RoundTrip returns the canned response
+func (t cannedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": {"application/hal+json"}},
+		Body:       io.NopCloser(strings.NewReader(t.body)),
+		Request:    req,
+	}, nil
+}
+
+func BenchmarkFetch(b *testing.B) {
+	payments := NewPaymentService("test_x", WithTransport(cannedTransport{body: `{"resource":"payment","id":"tr_WDqYK6vllg","status":"open","amount":{"currency":"EUR","value":"10.00"}}`}))
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := payments.Fetch("tr_WDqYK6vllg"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkCreate(b *testing.B) {
+	payments := NewPaymentService("test_x", WithTransport(cannedTransport{body: `{"resource":"payment","id":"tr_WDqYK6vllg","status":"open","amount":{"currency":"EUR","value":"10.00"}}`}))
+	amount, err := NewAmount("EUR", "10.00")
+	if err != nil {
+		b.Fatal(err)
+	}
+	request := &PaymentRequest{Amount: amount, Description: "Order #12345", RedirectUrl: "https://webshop.example.org/order/12345/"}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := payments.Create(request); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
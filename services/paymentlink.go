@@ -0,0 +1,180 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/dghubble/sling"
+)
+
+// PaymentLink is a payment link, a hosted page on which customers can pay
+// without a checkout integration
+// https://docs.mollie.com/reference/v2/payment-links-api/get-payment-link#response
+type PaymentLink struct {
+	Resource       string           `json:"resource" bson:"resource"`
+	ID             string           `json:"id" bson:"id"`
+	Mode           string           `json:"mode" bson:"mode"`
+	Description    string           `json:"description" bson:"description"`
+	Amount         *Amount          `json:"amount" bson:"amount"`
+	MinimumAmount  *Amount          `json:"minimumAmount" bson:"minimumAmount"`
+	Archived       bool             `json:"archived" bson:"archived"`
+	RedirectUrl    string           `json:"redirectUrl" bson:"redirectUrl"`
+	WebhookUrl     string           `json:"webhookUrl" bson:"webhookUrl"`
+	ProfileID      string           `json:"profileId" bson:"profileId"`
+	Reusable       bool             `json:"reusable" bson:"reusable"`
+	AllowedMethods []string         `json:"allowedMethods" bson:"allowedMethods"`
+	CreatedAt      *time.Time       `json:"createdAt" bson:"createdAt"`
+	PaidAt         *time.Time       `json:"paidAt" bson:"paidAt"`
+	ExpiresAt      *time.Time       `json:"expiresAt" bson:"expiresAt"`
+	Links          PaymentLinkLinks `json:"_links" bson:"_links"`
+}
+
+// PaymentLinkLinks represents the _links object returned in a PaymentLink
+type PaymentLinkLinks struct {
+	Self          Link `json:"self" bson:"self"`
+	PaymentLink   Link `json:"paymentLink" bson:"paymentLink"`
+	Documentation Link `json:"documentation" bson:"documentation"`
+}
+
+// URL returns the URL of the hosted payment page to share with customers
+func (l PaymentLink) URL() string {
+	return l.Links.PaymentLink.Href
+}
+
+// Expired returns true if the link has an expiry date that has passed at now
+func (l PaymentLink) Expired(now time.Time) bool {
+	return l.ExpiresAt != nil && !now.Before(*l.ExpiresAt)
+}
+
+// PaymentLinkRequest is a payment link create request. Without an amount the
+// customer chooses the amount, of at least the minimum amount if set.
+// https://docs.mollie.com/reference/v2/payment-links-api/create-payment-link
+type PaymentLinkRequest struct {
+	Description    string     `json:"description" bson:"description"`
+	Amount         *Amount    `json:"amount,omitempty" bson:"amount,omitempty"`
+	MinimumAmount  *Amount    `json:"minimumAmount,omitempty" bson:"minimumAmount,omitempty"`
+	RedirectUrl    string     `json:"redirectUrl,omitempty" bson:"redirectUrl,omitempty"`
+	WebhookUrl     string     `json:"webhookUrl,omitempty" bson:"webhookUrl,omitempty"`
+	ExpiresAt      *time.Time `json:"expiresAt,omitempty" bson:"expiresAt,omitempty"`
+	Reusable       bool       `json:"reusable,omitempty" bson:"reusable,omitempty"`
+	AllowedMethods []string   `json:"allowedMethods,omitempty" bson:"allowedMethods,omitempty"`
+}
+
+// ExpiresAfter sets the link to expire d from now
+func (r *PaymentLinkRequest) ExpiresAfter(d time.Duration) {
+	expiresAt := time.Now().Add(d).UTC().Truncate(time.Second)
+	r.ExpiresAt = &expiresAt
+}
+
+// PaymentLinkUpdateRequest is a payment link update request. Empty fields
+// are left unchanged.
+// https://docs.mollie.com/reference/v2/payment-links-api/update-payment-link
+type PaymentLinkUpdateRequest struct {
+	Description    string   `json:"description,omitempty" bson:"description,omitempty"`
+	MinimumAmount  *Amount  `json:"minimumAmount,omitempty" bson:"minimumAmount,omitempty"`
+	Archived       *bool    `json:"archived,omitempty" bson:"archived,omitempty"`
+	AllowedMethods []string `json:"allowedMethods,omitempty" bson:"allowedMethods,omitempty"`
+}
+
+// PaymentLinkList is a list of payment link objects and list metadata
+// https://docs.mollie.com/reference/v2/payment-links-api/list-payment-links
+type PaymentLinkList = List[*PaymentLink]
+
+// PaymentLinkService provides methods for managing payment links.
+type PaymentLinkService struct {
+	sling *sling.Sling
+}
+
+// NewPaymentLinkService returns a new PaymentLinkService.
+func NewPaymentLinkService(accessToken string, opts ...ClientOption) *PaymentLinkService {
+	// Create mollie api client
+	client := NewClient(accessToken, opts...)
+
+	return &PaymentLinkService{
+		sling: client,
+	}
+}
+
+// List returns the payment links
+func (s *PaymentLinkService) List(params *ListParams, opts ...RequestOption) (PaymentLinkList, *http.Response, error) {
+	return doGet[PaymentLinkList](s.sling, "payment-links", params, opts...)
+}
+
+// Fetch returns a payment link
+func (s *PaymentLinkService) Fetch(paymentLinkId string, opts ...RequestOption) (PaymentLink, *http.Response, error) {
+	return doGet[PaymentLink](s.sling, fmt.Sprintf("payment-links/%s", paymentLinkId), nil, opts...)
+}
+
+// Create creates a new payment link. Share its URL with the customer.
+func (s *PaymentLinkService) Create(paymentLinkBody *PaymentLinkRequest, opts ...RequestOption) (PaymentLink, *http.Response, error) {
+	if paymentLinkBody == nil {
+		return PaymentLink{}, nil, errRequiredBody
+	}
+	if paymentLinkBody.Amount != nil {
+		if err := paymentLinkBody.Amount.Validate(); err != nil {
+			return PaymentLink{}, nil, ValidationError{Field: "amount", Message: err.Error()}
+		}
+	}
+	if paymentLinkBody.MinimumAmount != nil {
+		if err := paymentLinkBody.MinimumAmount.Validate(); err != nil {
+			return PaymentLink{}, nil, ValidationError{Field: "minimumAmount", Message: err.Error()}
+		}
+	}
+
+	return doPost[PaymentLink](s.sling, "payment-links", paymentLinkBody, opts...)
+}
+
+// Update updates a payment link
+func (s *PaymentLinkService) Update(paymentLinkId string, paymentLinkBody *PaymentLinkUpdateRequest, opts ...RequestOption) (PaymentLink, *http.Response, error) {
+	return doPatch[PaymentLink](s.sling, fmt.Sprintf("payment-links/%s", paymentLinkId), paymentLinkBody, opts...)
+}
+
+// Archive archives a payment link, so it can no longer be paid
+func (s *PaymentLinkService) Archive(paymentLinkId string, opts ...RequestOption) (PaymentLink, *http.Response, error) {
+	archived := true
+	return s.Update(paymentLinkId, &PaymentLinkUpdateRequest{Archived: &archived}, opts...)
+}
+
+// Delete deletes a payment link that has not been paid
+func (s *PaymentLinkService) Delete(paymentLinkId string, opts ...RequestOption) (*http.Response, error) {
+	_, resp, err := doDelete[struct{}](s.sling, fmt.Sprintf("payment-links/%s", paymentLinkId), opts...)
+	return resp, err
+}
+
+// PaymentList returns the payments made through a payment link
+func (s *PaymentLinkService) PaymentList(paymentLinkId string, params *ListParams, opts ...RequestOption) (PaymentList, *http.Response, error) {
+	return doGet[PaymentList](s.sling, fmt.Sprintf("payment-links/%s/payments", paymentLinkId), params, opts...)
+}
+
+// PaymentIter returns an iterator over all payments made through a payment
+// link, starting at params
+func (s *PaymentLinkService) PaymentIter(paymentLinkId string, params *ListParams, opts ...RequestOption) *PaymentIterator {
+	it := new(PaymentIterator)
+	it.load = func(next string) (PaymentList, error) {
+		if next == "" {
+			page, _, err := s.PaymentList(paymentLinkId, params, opts...)
+			return page, err
+		}
+		page, _, err := doGetNext[PaymentList](s.sling, next, it.ctx, opts)
+		return page, err
+	}
+
+	return it
+}
+
+// PaymentsByStatus returns the payments made through a payment link grouped
+// by payment status, following all pages up to MaxAllPages
+func (s *PaymentLinkService) PaymentsByStatus(ctx context.Context, paymentLinkId string, params *ListParams, opts ...RequestOption) (map[string][]*Payment, error) {
+	it := s.PaymentIter(paymentLinkId, params, contextOptions(ctx, opts)...)
+	it.limit(ctx, MaxAllPages)
+
+	payments := make(map[string][]*Payment)
+	for it.Next() {
+		payment := it.Payment()
+		payments[payment.Status] = append(payments[payment.Status], payment)
+	}
+
+	return payments, it.Err()
+}
@@ -0,0 +1,54 @@
+package services
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/dghubble/sling"
+)
+
+// Permission is a permission an OAuth access token can be granted, eg.
+// "payments.write"
+// https://docs.mollie.com/reference/v2/permissions-api/get-permission
+type Permission struct {
+	Resource    string          `json:"resource" bson:"resource"`
+	ID          string          `json:"id" bson:"id"`
+	Description string          `json:"description" bson:"description"`
+	Granted     bool            `json:"granted" bson:"granted"`
+	Links       PermissionLinks `json:"_links" bson:"_links"`
+}
+
+// PermissionLinks represents the _links object returned in a Permission
+type PermissionLinks struct {
+	Self          Link `json:"self" bson:"self"`
+	Documentation Link `json:"documentation" bson:"documentation"`
+}
+
+// PermissionList is a list of permissions
+type PermissionList = List[*Permission]
+
+// PermissionService provides methods for checking the permissions of the
+// access token. The Permissions API is only available to OAuth access tokens.
+type PermissionService struct {
+	sling *sling.Sling
+}
+
+// NewPermissionService returns a new PermissionService.
+func NewPermissionService(accessToken string, opts ...ClientOption) *PermissionService {
+	// Create mollie api client
+	client := NewClient(accessToken, opts...)
+
+	return &PermissionService{
+		sling: client,
+	}
+}
+
+// List returns all permissions, and whether the access token was granted them
+func (s *PermissionService) List(opts ...RequestOption) (PermissionList, *http.Response, error) {
+	return doGet[PermissionList](s.sling, "permissions", nil, opts...)
+}
+
+// Fetch returns a permission, and whether the access token was granted it
+func (s *PermissionService) Fetch(permissionId string, opts ...RequestOption) (Permission, *http.Response, error) {
+	return doGet[Permission](s.sling, fmt.Sprintf("permissions/%s", permissionId), nil, opts...)
+}
@@ -0,0 +1,53 @@
+package services
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RateLimitError is returned when Mollie rate limits a request
+type RateLimitError struct {
+	// RetryAfter is how long to wait before retrying, zero if Mollie did not say
+	RetryAfter time.Duration
+
+	// Response is the metadata of the error response
+	Response ResponseInfo
+}
+
+// newRateLimitError returns the RateLimitError for a 429 response
+func newRateLimitError(resp *http.Response) *RateLimitError {
+	return &RateLimitError{
+		RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+		Response:   NewResponseInfo(resp),
+	}
+}
+
+// Error is a formatted rate limit error
+func (e *RateLimitError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("Mollie rate limit exceeded, retry after %v", e.RetryAfter)
+	}
+
+	return "Mollie rate limit exceeded"
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an HTTP
+// date relative to now
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil && date.After(now) {
+		return date.Sub(now)
+	}
+
+	return 0
+}
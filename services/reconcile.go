@@ -0,0 +1,212 @@
+package services
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/rollick/decimal"
+)
+
+// unknownMethod is the method key of refunds and chargebacks for payments
+// that are not part of the settlement
+const unknownMethod = "unknown"
+
+// Reconciliation is a settlement reconciled against the payments, refunds
+// and chargebacks it contains, for export to accounting
+type Reconciliation struct {
+	Settlement Settlement
+
+	// Totals of the settlement amounts by resource type
+	Payments    ReconciliationTotal
+	Refunds     ReconciliationTotal
+	Chargebacks ReconciliationTotal
+	Costs       ReconciliationTotal
+
+	// Net totals by day (YYYY-MM-DD) and by payment method, sorted by key
+	Days    []ReconciliationTotal
+	Methods []ReconciliationTotal
+
+	// Total is the net total of payments, refunds and chargebacks less the
+	// costs, and Difference what the settlement amount differs from it
+	Total      Amount
+	Difference Amount
+}
+
+// ReconciliationTotal is the total of a group of settled transactions
+type ReconciliationTotal struct {
+	Key    string
+	Count  int
+	Amount Amount
+}
+
+// Balanced returns true if the settlement amount matches the transactions
+func (r Reconciliation) Balanced() bool {
+	return r.Difference.mustDecimal().Cmp(decimal.New(0, 0)) == 0
+}
+
+// Reconcile fetches a settlement with its payments, refunds and chargebacks
+// and verifies they add up to the settlement amount
+func (s *SettlementService) Reconcile(ctx context.Context, settlementId string, opts ...RequestOption) (*Reconciliation, error) {
+	settlement, _, err := s.Fetch(settlementId, contextOptions(ctx, opts)...)
+	if err != nil {
+		return nil, err
+	}
+	payments, err := s.AllPayments(ctx, settlementId, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+	refunds, err := s.AllRefunds(ctx, settlementId, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+	chargebacks, err := s.AllChargebacks(ctx, settlementId, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return reconcile(settlement, payments, refunds, chargebacks), nil
+}
+
+// reconcile aggregates the settled transactions of a settlement
+func reconcile(settlement Settlement, payments []*Payment, refunds []*PaymentRefund, chargebacks []*PaymentChargeback) *Reconciliation {
+	currency := settlement.Amount.Currency
+	t := newTotals()
+
+	paymentMethods := make(map[string]string)
+	for _, p := range payments {
+		paymentMethods[p.ID] = p.Method
+		day := p.CreatedAt
+		if p.PaidAt != nil {
+			day = p.PaidAt
+		}
+		t.add("payments", day, p.Method, settledAmount(p.SettlementAmount, p.Amount, false))
+	}
+	for _, r := range refunds {
+		t.add("refunds", r.CreatedAt, methodOf(paymentMethods, r.PaymentID), settledAmount(r.SettlementAmount, r.Amount, true))
+	}
+	for _, c := range chargebacks {
+		t.add("chargebacks", c.CreatedAt, methodOf(paymentMethods, c.PaymentID), settledAmount(c.SettlementAmount, c.Amount, true))
+	}
+	for _, months := range settlement.Periods {
+		for _, period := range months {
+			for _, cost := range period.Costs {
+				t.addCost(cost.AmountGross.mustDecimal(), cost.Count)
+			}
+		}
+	}
+
+	total := t.net.Sub(t.costs.amount)
+	return &Reconciliation{
+		Settlement:  settlement,
+		Payments:    t.byType["payments"].total("payments", currency),
+		Refunds:     t.byType["refunds"].total("refunds", currency),
+		Chargebacks: t.byType["chargebacks"].total("chargebacks", currency),
+		Costs:       t.costs.total("costs", currency),
+		Days:        sortedTotals(t.byDay, currency),
+		Methods:     sortedTotals(t.byMethod, currency),
+		Total:       newAmount(currency, total),
+		Difference:  newAmount(currency, settlement.Amount.mustDecimal().Sub(total)),
+	}
+}
+
+// settledAmount returns the settlement amount, or the amount (negated for
+// refunds and chargebacks) if the settlement amount is not known
+func settledAmount(settlementAmount *Amount, amount Amount, negate bool) decimal.Decimal {
+	if settlementAmount != nil {
+		return settlementAmount.mustDecimal()
+	}
+	value := amount.mustDecimal()
+	if negate {
+		value = value.Mul(decimal.New(-1, 0))
+	}
+
+	return value
+}
+
+// methodOf returns the method of a settled payment, or unknownMethod
+func methodOf(paymentMethods map[string]string, paymentId string) string {
+	if method, ok := paymentMethods[paymentId]; ok && method != "" {
+		return method
+	}
+
+	return unknownMethod
+}
+
+// subtotal is a running total of a group of transactions
+type subtotal struct {
+	count  int
+	amount decimal.Decimal
+}
+
+// total returns the subtotal as a ReconciliationTotal
+func (s *subtotal) total(key string, currency string) ReconciliationTotal {
+	if s == nil {
+		s = &subtotal{}
+	}
+
+	return ReconciliationTotal{Key: key, Count: s.count, Amount: newAmount(currency, s.amount)}
+}
+
+// totals are the running totals of a reconciliation
+type totals struct {
+	net      decimal.Decimal
+	costs    *subtotal
+	byType   map[string]*subtotal
+	byDay    map[string]*subtotal
+	byMethod map[string]*subtotal
+}
+
+// newTotals returns empty totals
+func newTotals() *totals {
+	return &totals{
+		costs:    &subtotal{},
+		byType:   make(map[string]*subtotal),
+		byDay:    make(map[string]*subtotal),
+		byMethod: make(map[string]*subtotal),
+	}
+}
+
+// add adds a settled transaction to the totals
+func (t *totals) add(kind string, at *time.Time, method string, amount decimal.Decimal) {
+	day := "unknown"
+	if at != nil {
+		day = at.Format(dateLayout)
+	}
+	if method == "" {
+		method = unknownMethod
+	}
+
+	t.net = t.net.Add(amount)
+	for _, group := range []struct {
+		m   map[string]*subtotal
+		key string
+	}{{t.byType, kind}, {t.byDay, day}, {t.byMethod, method}} {
+		s, ok := group.m[group.key]
+		if !ok {
+			s = &subtotal{}
+			group.m[group.key] = s
+		}
+		s.count++
+		s.amount = s.amount.Add(amount)
+	}
+}
+
+// addCost adds settlement costs to the totals
+func (t *totals) addCost(amount decimal.Decimal, count int) {
+	t.costs.count += count
+	t.costs.amount = t.costs.amount.Add(amount)
+}
+
+// sortedTotals returns the subtotals sorted by key
+func sortedTotals(subtotals map[string]*subtotal, currency string) []ReconciliationTotal {
+	result := make([]ReconciliationTotal, 0, len(subtotals))
+	for key, s := range subtotals {
+		result = append(result, s.total(key, currency))
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Key < result[j].Key
+	})
+
+	return result
+}
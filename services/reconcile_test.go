@@ -0,0 +1,137 @@
+package services
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestReconcile(t *testing.T) {
+	at := func(day int) *time.Time {
+		t := time.Date(2018, 4, day, 12, 0, 0, 0, time.UTC)
+		return &t
+	}
+	eur := func(value string) *Amount {
+		a := MustAmount("EUR", value)
+		return &a
+	}
+	total := func(key string, count int, value string) ReconciliationTotal {
+		return ReconciliationTotal{Key: key, Count: count, Amount: MustAmount("EUR", value)}
+	}
+	costs := func(gross ...string) map[string]map[string]SettlementPeriod {
+		periods := map[string]map[string]SettlementPeriod{"2018": {}}
+		for i, value := range gross {
+			periods["2018"][string(rune('1'+i))] = SettlementPeriod{
+				Costs: []SettlementCost{{Count: 1, AmountGross: *eur(value)}},
+			}
+		}
+		return periods
+	}
+
+	payments := []*Payment{
+		{ID: "tr_1", Method: MethodIDEAL, Amount: *eur("50.00"), SettlementAmount: eur("50.00"), CreatedAt: at(1), PaidAt: at(1)},
+		{ID: "tr_2", Method: MethodCreditCard, Amount: *eur("50.00"), CreatedAt: at(2)},
+	}
+	refunds := []*PaymentRefund{
+		{ID: "re_1", PaymentID: "tr_1", Amount: *eur("5.00"), SettlementAmount: eur("-5.00"), CreatedAt: at(2)},
+	}
+	chargebacks := []*PaymentChargeback{
+		{ID: "chb_1", PaymentID: "tr_other", Amount: *eur("5.00"), CreatedAt: at(3)},
+	}
+
+	tests := []struct {
+		name        string
+		settlement  Settlement
+		payments    []*Payment
+		refunds     []*PaymentRefund
+		chargebacks []*PaymentChargeback
+		want        Reconciliation
+		balanced    bool
+	}{
+		{
+			name:        "balanced",
+			settlement:  Settlement{Amount: *eur("88.00"), Periods: costs("1.50", "0.50")},
+			payments:    payments,
+			refunds:     refunds,
+			chargebacks: chargebacks,
+			want: Reconciliation{
+				Payments:    total("payments", 2, "100.00"),
+				Refunds:     total("refunds", 1, "-5.00"),
+				Chargebacks: total("chargebacks", 1, "-5.00"),
+				Costs:       total("costs", 2, "2.00"),
+				Days: []ReconciliationTotal{
+					total("2018-04-01", 1, "50.00"),
+					total("2018-04-02", 2, "45.00"),
+					total("2018-04-03", 1, "-5.00"),
+				},
+				Methods: []ReconciliationTotal{
+					total(MethodCreditCard, 1, "50.00"),
+					total(MethodIDEAL, 2, "45.00"),
+					total(unknownMethod, 1, "-5.00"),
+				},
+				Total:      *eur("88.00"),
+				Difference: *eur("0.00"),
+			},
+			balanced: true,
+		},
+		{
+			name:       "difference",
+			settlement: Settlement{Amount: *eur("52.00"), Periods: costs("1.00")},
+			payments:   payments[:1],
+			want: Reconciliation{
+				Payments:    total("payments", 1, "50.00"),
+				Refunds:     total("refunds", 0, "0.00"),
+				Chargebacks: total("chargebacks", 0, "0.00"),
+				Costs:       total("costs", 1, "1.00"),
+				Days:        []ReconciliationTotal{total("2018-04-01", 1, "50.00")},
+				Methods:     []ReconciliationTotal{total(MethodIDEAL, 1, "50.00")},
+				Total:       *eur("49.00"),
+				Difference:  *eur("3.00"),
+			},
+		},
+		{
+			name:       "unknown day and method",
+			settlement: Settlement{Amount: *eur("10.00")},
+			payments:   []*Payment{{ID: "tr_3", Amount: *eur("10.00")}},
+			want: Reconciliation{
+				Payments:    total("payments", 1, "10.00"),
+				Refunds:     total("refunds", 0, "0.00"),
+				Chargebacks: total("chargebacks", 0, "0.00"),
+				Costs:       total("costs", 0, "0.00"),
+				Days:        []ReconciliationTotal{total("unknown", 1, "10.00")},
+				Methods:     []ReconciliationTotal{total(unknownMethod, 1, "10.00")},
+				Total:       *eur("10.00"),
+				Difference:  *eur("0.00"),
+			},
+			balanced: true,
+		},
+		{
+			name:       "empty",
+			settlement: Settlement{Amount: *eur("0.00")},
+			want: Reconciliation{
+				Payments:    total("payments", 0, "0.00"),
+				Refunds:     total("refunds", 0, "0.00"),
+				Chargebacks: total("chargebacks", 0, "0.00"),
+				Costs:       total("costs", 0, "0.00"),
+				Days:        []ReconciliationTotal{},
+				Methods:     []ReconciliationTotal{},
+				Total:       *eur("0.00"),
+				Difference:  *eur("0.00"),
+			},
+			balanced: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := reconcile(tt.settlement, tt.payments, tt.refunds, tt.chargebacks)
+			tt.want.Settlement = tt.settlement
+			if !reflect.DeepEqual(*got, tt.want) {
+				t.Errorf("got  %+v\nwant %+v", *got, tt.want)
+			}
+			if got.Balanced() != tt.balanced {
+				t.Errorf("balanced %v, want %v", got.Balanced(), tt.balanced)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// RefundStatus is the status of a refund
+// https://docs.mollie.com/reference/v2/refunds-api/get-refund#response
+type RefundStatus string
+
+// Refund statuses
+const (
+	RefundStatusQueued     RefundStatus = "queued"
+	RefundStatusPending    RefundStatus = "pending"
+	RefundStatusProcessing RefundStatus = "processing"
+	RefundStatusRefunded   RefundStatus = "refunded"
+	RefundStatusFailed     RefundStatus = "failed"
+)
+
+// Refund is a payment or order refund object
+// https://docs.mollie.com/reference/v2/refunds-api/get-refund#response
+type Refund struct {
+	Resource         string          `json:"resource" bson:"resource"`
+	ID               string          `json:"id" bson:"id"`
+	Amount           Amount          `json:"amount" bson:"amount"`
+	SettlementAmount *Amount         `json:"settlementAmount" bson:"settlementAmount"`
+	Description      string          `json:"description" bson:"description"`
+	Metadata         json.RawMessage `json:"metadata" bson:"metadata"`
+	Status           RefundStatus    `json:"status" bson:"status"`
+	PaymentID        string          `json:"paymentId" bson:"paymentId"`
+	OrderID          string          `json:"orderId" bson:"orderId"`
+	CaptureID        string          `json:"captureId" bson:"captureId"`
+	CreatedAt        *time.Time      `json:"createdAt" bson:"createdAt"`
+	Links            RefundLinks     `json:"_links" bson:"_links"`
+}
+
+// RefundLinks represents the _links object returned in a Refund
+// https://docs.mollie.com/reference/v2/refunds-api/get-refund#response
+type RefundLinks struct {
+	Self          Link `json:"self" bson:"self"`
+	Payment       Link `json:"payment" bson:"payment"`
+	Settlement    Link `json:"settlement" bson:"settlement"`
+	Order         Link `json:"order" bson:"order"`
+	Capture       Link `json:"capture" bson:"capture"`
+	Documentation Link `json:"documentation" bson:"documentation"`
+}
+
+// String summarizes the refund for logging, leaving out the metadata
+func (r Refund) String() string {
+	return fmt.Sprintf("refund %s of payment %s (%s, %s)", r.ID, r.PaymentID, r.Status, r.Amount)
+}
@@ -0,0 +1,203 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+
+	"github.com/dghubble/sling"
+)
+
+// receive sends the request built on s and decodes the response into a T.
+// Mollie error responses are returned as a MollieError.
+func receive[T any](s *sling.Sling, o *requestOptions) (T, *http.Response, error) {
+	v := new(T)
+	resp, err := receiveInto(s, v, o)
+
+	return *v, resp, err
+}
+
+// receiveInto sends the request built on s and decodes the response into v
+func receiveInto(s *sling.Sling, v interface{}, o *requestOptions) (*http.Response, error) {
+	req, err := s.Request()
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(o.context())
+
+	mollieError := new(MollieError)
+	resp, err := s.Do(req, v, mollieError)
+	var transportError *TransportError
+	if resp != nil && !errors.As(err, &transportError) {
+		if respErr := responseError(resp, mollieError); respErr != nil {
+			return resp, respErr
+		}
+	}
+	if err == io.EOF && resp != nil && resp.StatusCode < 300 {
+		// Empty success body, eg. 204 No Content from a delete: nothing to decode
+		err = nil
+	}
+
+	return resp, err
+}
+
+// responseError returns the error for an unsuccessful response, nil for a
+// successful one. Errors are detected by the status code alone; mollieError
+// is the decoded error body, completed from the response if it is empty.
+func responseError(resp *http.Response, mollieError *MollieError) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return newRateLimitError(resp)
+	}
+
+	if mollieError.Status == 0 {
+		mollieError.Status = resp.StatusCode
+	}
+	if mollieError.Title == "" {
+		mollieError.Title = http.StatusText(resp.StatusCode)
+	}
+	mollieError.Response = NewResponseInfo(resp)
+
+	return mollieError
+}
+
+// readResponseError returns the error for an unsuccessful response, reading
+// the Mollie error from the body, nil for a successful one. It is for
+// responses read without sling, such as downloads and streamed lists.
+func readResponseError(resp *http.Response) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	mollieError := new(MollieError)
+	if resp.StatusCode != http.StatusTooManyRequests {
+		if err := json.NewDecoder(resp.Body).Decode(mollieError); err != nil {
+			return err
+		}
+	}
+
+	return responseError(resp, mollieError)
+}
+
+// doGet fetches the resource at path, encoding params (if any) in the query
+func doGet[T any](s *sling.Sling, path string, params interface{}, opts ...RequestOption) (T, *http.Response, error) {
+	if err := validateParams(params); err != nil {
+		var v T
+		return v, nil, err
+	}
+
+	o := newRequestOptions(opts)
+	req := o.apply(s.New().Get(path), false)
+	if !isNil(params) {
+		req = req.QueryStruct(params)
+	}
+
+	return receive[T](req, o)
+}
+
+// doGetNext fetches the list page at next, the next link of a previous page,
+// with the request options of the first page. The link already carries the
+// query of the first page, so options in it are not sent again. ctx, if not
+// nil, replaces the context set by opts.
+func doGetNext[T any](s *sling.Sling, next string, ctx context.Context, opts []RequestOption) (T, *http.Response, error) {
+	o := newRequestOptions(opts)
+	if ctx != nil {
+		o.Context = ctx
+	}
+
+	nextURL, err := url.Parse(next)
+	if err != nil {
+		var v T
+		return v, nil, err
+	}
+
+	req := s.New().Get(next)
+	if q := o.query(false).without(nextURL.Query()); !q.empty() {
+		req = req.QueryStruct(q)
+	}
+
+	return receive[T](req, o)
+}
+
+// validateParams validates query params that have a Validate method, such
+// as list params
+func validateParams(params interface{}) error {
+	v, ok := params.(interface{ Validate() error })
+	if !ok || isNil(params) {
+		return nil
+	}
+
+	return v.Validate()
+}
+
+// isNil returns true if params is nil or a nil pointer, such as nil list
+// params, so there is no query to encode
+func isNil(params interface{}) bool {
+	if params == nil {
+		return true
+	}
+	rv := reflect.ValueOf(params)
+
+	return rv.Kind() == reflect.Ptr && rv.IsNil()
+}
+
+// doPost posts body as JSON to path
+func doPost[T any](s *sling.Sling, path string, body interface{}, opts ...RequestOption) (T, *http.Response, error) {
+	return doWithBody[T](s.New().Post(path), body, opts)
+}
+
+// doPatch patches the resource at path with body as JSON
+func doPatch[T any](s *sling.Sling, path string, body interface{}, opts ...RequestOption) (T, *http.Response, error) {
+	return doWithBody[T](s.New().Patch(path), body, opts)
+}
+
+// doDelete deletes the resource at path
+func doDelete[T any](s *sling.Sling, path string, opts ...RequestOption) (T, *http.Response, error) {
+	return doWithBody[T](s.New().Delete(path), nil, opts)
+}
+
+// doWithBody sends the request built on s with body as JSON, if there is one
+func doWithBody[T any](s *sling.Sling, body interface{}, opts []RequestOption) (T, *http.Response, error) {
+	o := newRequestOptions(opts)
+	body, err := o.body(body)
+	if err != nil {
+		var v T
+		return v, nil, err
+	}
+
+	req := o.apply(s, true)
+	if body != nil {
+		req = req.BodyProvider(jsonBody{body})
+	}
+
+	return receive[T](req, o)
+}
+
+// jsonBody is a sling body provider encoding the body with json.Marshal,
+// which reuses the encoding buffers of encoding/json, rather than with a new
+// json.Encoder and growing buffer for every request as BodyJSON does
+type jsonBody struct {
+	payload interface{}
+}
+
+// ContentType returns the JSON content type
+func (b jsonBody) ContentType() string {
+	return "application/json"
+}
+
+// Body returns the encoded body
+func (b jsonBody) Body() (io.Reader, error) {
+	data, err := json.Marshal(b.payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.NewReader(data), nil
+}
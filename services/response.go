@@ -0,0 +1,46 @@
+package services
+
+import (
+	"net/http"
+)
+
+// requestIDHeaders are the response headers that may carry the identifier
+// of a request, in order of preference
+var requestIDHeaders = []string{"X-Request-Id", "X-Mollie-Request-Id", "Request-Id"}
+
+// ResponseInfo is the metadata of an API response, to give context when
+// reporting a failed call to Mollie support
+type ResponseInfo struct {
+	StatusCode  int
+	RequestID   string
+	ContentType string
+	Date        string
+	Method      string
+	URL         string
+}
+
+// NewResponseInfo returns the metadata of resp. It is safe to call with a
+// nil response.
+func NewResponseInfo(resp *http.Response) ResponseInfo {
+	if resp == nil {
+		return ResponseInfo{}
+	}
+
+	info := ResponseInfo{
+		StatusCode:  resp.StatusCode,
+		ContentType: resp.Header.Get("Content-Type"),
+		Date:        resp.Header.Get("Date"),
+	}
+	for _, header := range requestIDHeaders {
+		if id := resp.Header.Get(header); id != "" {
+			info.RequestID = id
+			break
+		}
+	}
+	if resp.Request != nil {
+		info.Method = resp.Request.Method
+		info.URL = resp.Request.URL.String()
+	}
+
+	return info
+}
@@ -0,0 +1,119 @@
+package services
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// TestJSONRoundTrip decodes the Mollie responses in testdata, encodes them
+// again and checks the result is the same JSON and decodes to the same
+// resource
+func TestJSONRoundTrip(t *testing.T) {
+	tests := []struct {
+		file string
+		new  func() interface{}
+	}{
+		{"payment.json", func() interface{} { return new(Payment) }},
+		{"payment_application_fee.json", func() interface{} { return new(Payment) }},
+		{"refund.json", func() interface{} { return new(Refund) }},
+		{"chargeback.json", func() interface{} { return new(Chargeback) }},
+		{"capture.json", func() interface{} { return new(Capture) }},
+		{"customer.json", func() interface{} { return new(Customer) }},
+		{"mandate.json", func() interface{} { return new(Mandate) }},
+		{"subscription.json", func() interface{} { return new(Subscription) }},
+		{"settlement.json", func() interface{} { return new(Settlement) }},
+		{"method.json", func() interface{} { return new(Method) }},
+		{"balance_transfer.json", func() interface{} { return new(BalanceTransfer) }},
+		{"payment_link.json", func() interface{} { return new(PaymentLink) }},
+		{"permission.json", func() interface{} { return new(Permission) }},
+		{"onboarding.json", func() interface{} { return new(Onboarding) }},
+		{"partner.json", func() interface{} { return new(Partner) }},
+		{"webhook.json", func() interface{} { return new(Webhook) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			golden, err := os.ReadFile(filepath.Join("testdata", tt.file))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resource := tt.new()
+			if err := json.Unmarshal(golden, resource); err != nil {
+				t.Fatalf("decoding: %v", err)
+			}
+			encoded, err := json.Marshal(resource)
+			if err != nil {
+				t.Fatalf("encoding: %v", err)
+			}
+
+			var want, got interface{}
+			if err := json.Unmarshal(golden, &want); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal(encoded, &got); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("encoded JSON differs from %s:\n%s", tt.file, encoded)
+			}
+
+			decoded := tt.new()
+			if err := json.Unmarshal(encoded, decoded); err != nil {
+				t.Fatalf("decoding again: %v", err)
+			}
+			if !reflect.DeepEqual(decoded, resource) {
+				t.Errorf("decoded %+v, want %+v", decoded, resource)
+			}
+		})
+	}
+}
+
+func TestPaymentWithoutEmbedded(t *testing.T) {
+	var payment Payment
+	if err := json.Unmarshal([]byte(`{"id":"tr_1"}`), &payment); err != nil {
+		t.Fatal(err)
+	}
+	if payment.Embedded != nil {
+		t.Errorf("got embedded %+v, want nil", payment.Embedded)
+	}
+
+	encoded, err := json.Marshal(payment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(encoded, &fields); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := fields["_embedded"]; ok {
+		t.Errorf("encoded _embedded for a payment without one: %s", fields["_embedded"])
+	}
+}
+
+func TestSequenceTypeJSON(t *testing.T) {
+	tests := []struct {
+		sequenceType SequenceType
+		want         string
+	}{
+		{"", `""`},
+		{SequenceTypeOneOff, `"oneoff"`},
+		{SequenceTypeFirst, `"first"`},
+		{SequenceTypeRecurring, `"recurring"`},
+		{"installment", `"installment"`},
+	}
+
+	for _, tt := range tests {
+		got, err := json.Marshal(tt.sequenceType)
+		if err != nil {
+			t.Errorf("%q: %v", tt.sequenceType, err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("%q encoded as %s, want %s", tt.sequenceType, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package services
+
+// SequenceType is the recurring sequence type of a payment. Sequence types
+// unknown to this package are kept as sent by Mollie; payment requests with
+// one fail validation.
+// https://docs.mollie.com/payments/recurring#payments-recurring-first-payment
+type SequenceType string
+
+// Payment sequence types
+const (
+	SequenceTypeOneOff    SequenceType = "oneoff"
+	SequenceTypeFirst     SequenceType = "first"
+	SequenceTypeRecurring SequenceType = "recurring"
+)
+
+// Valid returns true if t is a sequence type known to Mollie
+func (t SequenceType) Valid() bool {
+	switch t {
+	case SequenceTypeOneOff, SequenceTypeFirst, SequenceTypeRecurring:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,43 @@
+package services
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+// rewriteTransport sends all requests to the test server at url
+type rewriteTransport struct {
+	url *url.URL
+}
+
+// RoundTrip sends the request to the test server
+func (t rewriteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme, req.URL.Host = t.url.Scheme, t.url.Host
+
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// withTestServer returns the client option sending all requests, including
+// those to absolute Mollie links, to a test server running handler
+func withTestServer(t *testing.T, handler http.HandlerFunc) ClientOption {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	u, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return WithTransport(rewriteTransport{url: u})
+}
+
+// writeJSON writes body as a Mollie JSON response with status
+func writeJSON(w http.ResponseWriter, status int, body string) {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(status)
+	w.Write([]byte(body))
+}
@@ -0,0 +1,241 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/dghubble/sling"
+)
+
+// Settlement statuses
+// https://docs.mollie.com/reference/v2/settlements-api/get-settlement#response
+const (
+	SettlementStatusOpen    = "open"
+	SettlementStatusPending = "pending"
+	SettlementStatusPaidOut = "paidout"
+	SettlementStatusFailed  = "failed"
+)
+
+// Settlement is a settlement object
+// https://docs.mollie.com/reference/v2/settlements-api/get-settlement#response
+type Settlement struct {
+	Resource  string                                 `json:"resource" bson:"resource"`
+	ID        string                                 `json:"id" bson:"id"`
+	Reference string                                 `json:"reference" bson:"reference"`
+	CreatedAt *time.Time                             `json:"createdAt" bson:"createdAt"`
+	SettledAt *time.Time                             `json:"settledAt" bson:"settledAt"`
+	Status    string                                 `json:"status" bson:"status"`
+	Amount    Amount                                 `json:"amount" bson:"amount"`
+	Periods   map[string]map[string]SettlementPeriod `json:"periods" bson:"periods"`
+	InvoiceID string                                 `json:"invoiceId" bson:"invoiceId"`
+	Links     SettlementLinks                        `json:"_links" bson:"_links"`
+}
+
+// SettlementPeriod are the revenue and costs of a settlement in a month
+type SettlementPeriod struct {
+	Revenue   []SettlementRevenue `json:"revenue" bson:"revenue"`
+	Costs     []SettlementCost    `json:"costs" bson:"costs"`
+	InvoiceID string              `json:"invoiceId" bson:"invoiceId"`
+}
+
+// SettlementRevenue is the revenue of a payment method in a settlement period
+type SettlementRevenue struct {
+	Description string  `json:"description" bson:"description"`
+	Method      string  `json:"method" bson:"method"`
+	Count       int     `json:"count" bson:"count"`
+	AmountNet   Amount  `json:"amountNet" bson:"amountNet"`
+	AmountVat   *Amount `json:"amountVat" bson:"amountVat"`
+	AmountGross Amount  `json:"amountGross" bson:"amountGross"`
+}
+
+// SettlementCost are the costs of a payment method in a settlement period
+type SettlementCost struct {
+	Description string         `json:"description" bson:"description"`
+	Method      string         `json:"method" bson:"method"`
+	Count       int            `json:"count" bson:"count"`
+	Rate        SettlementRate `json:"rate" bson:"rate"`
+	AmountNet   Amount         `json:"amountNet" bson:"amountNet"`
+	AmountVat   *Amount        `json:"amountVat" bson:"amountVat"`
+	AmountGross Amount         `json:"amountGross" bson:"amountGross"`
+}
+
+// SettlementRate is the rate charged per transaction
+type SettlementRate struct {
+	Fixed      *Amount `json:"fixed" bson:"fixed"`
+	Percentage string  `json:"percentage" bson:"percentage"`
+}
+
+// SettlementLinks represents the _links object returned in a Settlement
+// https://docs.mollie.com/reference/v2/settlements-api/get-settlement#response
+type SettlementLinks struct {
+	Self          Link `json:"self" bson:"self"`
+	Payments      Link `json:"payments" bson:"payments"`
+	Refunds       Link `json:"refunds" bson:"refunds"`
+	Chargebacks   Link `json:"chargebacks" bson:"chargebacks"`
+	Captures      Link `json:"captures" bson:"captures"`
+	Invoice       Link `json:"invoice" bson:"invoice"`
+	Documentation Link `json:"documentation" bson:"documentation"`
+}
+
+// SettlementList is a list of settlement objects and list metadata
+// https://docs.mollie.com/reference/v2/settlements-api/list-settlements#response
+type SettlementList = List[*Settlement]
+
+// SettlementService provides methods for accessing settlements.
+type SettlementService struct {
+	sling *sling.Sling
+	doer  sling.Doer
+}
+
+// NewSettlementService returns a new SettlementService.
+func NewSettlementService(accessToken string, opts ...ClientOption) *SettlementService {
+	config := newClientConfig(opts)
+	doer := config.doer()
+
+	return &SettlementService{
+		sling: newClient(accessToken, config, doer),
+		doer:  doer,
+	}
+}
+
+// SettlementListParams are the params for a settlement list request
+// https://docs.mollie.com/reference/v2/settlements-api/list-settlements#parameters
+type SettlementListParams struct {
+	ListParams
+	BalanceID string `url:"balanceId,omitempty"`
+}
+
+// List returns all settlements
+func (s *SettlementService) List(params *SettlementListParams, opts ...RequestOption) (SettlementList, *http.Response, error) {
+	return doGet[SettlementList](s.sling, "settlements", params, opts...)
+}
+
+// Fetch returns a settlement
+func (s *SettlementService) Fetch(settlementId string, opts ...RequestOption) (Settlement, *http.Response, error) {
+	return doGet[Settlement](s.sling, fmt.Sprintf("settlements/%s", settlementId), nil, opts...)
+}
+
+// Next returns the settlement that will be paid out next
+func (s *SettlementService) Next(opts ...RequestOption) (Settlement, *http.Response, error) {
+	return doGet[Settlement](s.sling, "settlements/next", nil, opts...)
+}
+
+// Open returns the open balance that has not been settled yet
+func (s *SettlementService) Open(opts ...RequestOption) (Settlement, *http.Response, error) {
+	return doGet[Settlement](s.sling, "settlements/open", nil, opts...)
+}
+
+// PaymentList returns the payments in a settlement
+func (s *SettlementService) PaymentList(settlementId string, params *ListParams, opts ...RequestOption) (PaymentList, *http.Response, error) {
+	return doGet[PaymentList](s.sling, fmt.Sprintf("settlements/%s/payments", settlementId), params, opts...)
+}
+
+// RefundList returns the refunds in a settlement
+func (s *SettlementService) RefundList(settlementId string, params *ListParams, opts ...RequestOption) (PaymentRefundList, *http.Response, error) {
+	return doGet[PaymentRefundList](s.sling, fmt.Sprintf("settlements/%s/refunds", settlementId), params, opts...)
+}
+
+// ChargebackList returns the chargebacks in a settlement
+func (s *SettlementService) ChargebackList(settlementId string, params *ListParams, opts ...RequestOption) (PaymentChargebackList, *http.Response, error) {
+	return doGet[PaymentChargebackList](s.sling, fmt.Sprintf("settlements/%s/chargebacks", settlementId), params, opts...)
+}
+
+// PaymentIter returns an iterator over all payments in a settlement, starting at params
+func (s *SettlementService) PaymentIter(settlementId string, params *ListParams, opts ...RequestOption) *PaymentIterator {
+	it := new(PaymentIterator)
+	it.load = func(next string) (PaymentList, error) {
+		if next == "" {
+			page, _, err := s.PaymentList(settlementId, params, opts...)
+			return page, err
+		}
+		page, _, err := doGetNext[PaymentList](s.sling, next, it.ctx, opts)
+		return page, err
+	}
+
+	return it
+}
+
+// RefundIter returns an iterator over all refunds in a settlement, starting at params
+func (s *SettlementService) RefundIter(settlementId string, params *ListParams, opts ...RequestOption) *PaymentRefundIterator {
+	it := new(PaymentRefundIterator)
+	it.load = func(next string) (PaymentRefundList, error) {
+		if next == "" {
+			page, _, err := s.RefundList(settlementId, params, opts...)
+			return page, err
+		}
+		page, _, err := doGetNext[PaymentRefundList](s.sling, next, it.ctx, opts)
+		return page, err
+	}
+
+	return it
+}
+
+// ChargebackIter returns an iterator over all chargebacks in a settlement, starting at params
+func (s *SettlementService) ChargebackIter(settlementId string, params *ListParams, opts ...RequestOption) *PaymentChargebackIterator {
+	it := new(PaymentChargebackIterator)
+	it.load = func(next string) (PaymentChargebackList, error) {
+		if next == "" {
+			page, _, err := s.ChargebackList(settlementId, params, opts...)
+			return page, err
+		}
+		page, _, err := doGetNext[PaymentChargebackList](s.sling, next, it.ctx, opts)
+		return page, err
+	}
+
+	return it
+}
+
+// AllPayments returns all payments in a settlement, following all pages up to MaxAllPages
+func (s *SettlementService) AllPayments(ctx context.Context, settlementId string, params *ListParams, opts ...RequestOption) ([]*Payment, error) {
+	it := s.PaymentIter(settlementId, params, contextOptions(ctx, opts)...)
+	it.limit(ctx, MaxAllPages)
+
+	var payments []*Payment
+	for it.Next() {
+		payments = append(payments, it.Payment())
+	}
+
+	return payments, it.Err()
+}
+
+// AllRefunds returns all refunds in a settlement, following all pages up to MaxAllPages
+func (s *SettlementService) AllRefunds(ctx context.Context, settlementId string, params *ListParams, opts ...RequestOption) ([]*PaymentRefund, error) {
+	it := s.RefundIter(settlementId, params, contextOptions(ctx, opts)...)
+	it.limit(ctx, MaxAllPages)
+
+	var refunds []*PaymentRefund
+	for it.Next() {
+		refunds = append(refunds, it.Refund())
+	}
+
+	return refunds, it.Err()
+}
+
+// AllChargebacks returns all chargebacks in a settlement, following all pages up to MaxAllPages
+func (s *SettlementService) AllChargebacks(ctx context.Context, settlementId string, params *ListParams, opts ...RequestOption) ([]*PaymentChargeback, error) {
+	it := s.ChargebackIter(settlementId, params, contextOptions(ctx, opts)...)
+	it.limit(ctx, MaxAllPages)
+
+	var chargebacks []*PaymentChargeback
+	for it.Next() {
+		chargebacks = append(chargebacks, it.Chargeback())
+	}
+
+	return chargebacks, it.Err()
+}
+
+// Stream calls fn for each settlement, starting at params and following all
+// pages, decoding the settlements one at a time from the response. An error
+// returned by fn stops the stream and is returned.
+func (s *SettlementService) Stream(ctx context.Context, params *SettlementListParams, fn func(*Settlement) error, opts ...RequestOption) error {
+	return streamList(ctx, s.sling, s.doer, "settlements", params, fn, opts)
+}
+
+// StreamPayments calls fn for each payment in a settlement, starting at
+// params and following all pages, decoding the payments one at a time from
+// the response. An error returned by fn stops the stream and is returned.
+func (s *SettlementService) StreamPayments(ctx context.Context, settlementId string, params *ListParams, fn func(*Payment) error, opts ...RequestOption) error {
+	return streamList(ctx, s.sling, s.doer, fmt.Sprintf("settlements/%s/payments", settlementId), params, fn, opts)
+}
@@ -0,0 +1,32 @@
+package services
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestSettlementListParams(t *testing.T) {
+	tests := []struct {
+		name   string
+		params *SettlementListParams
+		query  string
+	}{
+		{"nil", nil, ""},
+		{"balance", &SettlementListParams{BalanceID: "bal_gVMhHKqSSRYJyPsuoPNFH"}, "balanceId=bal_gVMhHKqSSRYJyPsuoPNFH"},
+		{"balance and limit", &SettlementListParams{ListParams: ListParams{Limit: 10}, BalanceID: "bal_gVMhHKqSSRYJyPsuoPNFH"}, "balanceId=bal_gVMhHKqSSRYJyPsuoPNFH&limit=10"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settlements := NewSettlementService("test_x", withTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v2/settlements" || r.URL.RawQuery != tt.query {
+					t.Errorf("got %s?%s, want /v2/settlements?%s", r.URL.Path, r.URL.RawQuery, tt.query)
+				}
+				writeJSON(w, http.StatusOK, `{"count":0,"_embedded":{"settlements":[]}}`)
+			}))
+			if _, _, err := settlements.List(tt.params); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Webhook handler defaults
+const (
+	DefaultSinkRetries    = 3
+	DefaultSinkRetryDelay = 500 * time.Millisecond
+)
+
+// signatureHeader is the header carrying the signature of an event webhook call
+const signatureHeader = "X-Mollie-Signature"
+
+// Sink processes webhook calls, eg. by pushing them onto a queue. Calls are
+// delivered at least once, so processing must be idempotent.
+type Sink interface {
+	Handle(ctx context.Context, call WebhookCall) error
+}
+
+// SinkFunc is a function implementing Sink
+type SinkFunc func(ctx context.Context, call WebhookCall) error
+
+// Handle processes the webhook call
+func (f SinkFunc) Handle(ctx context.Context, call WebhookCall) error {
+	return f(ctx, call)
+}
+
+// ChannelSink returns a Sink sending webhook calls on ch. It fails when the
+// call is not received before the webhook request is done.
+func ChannelSink(ch chan<- WebhookCall) Sink {
+	return SinkFunc(func(ctx context.Context, call WebhookCall) error {
+		select {
+		case ch <- call:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// WebhookHandler is an http.Handler parsing webhook calls and forwarding
+// them to a Sink. Event calls are verified against the webhook secret, and
+// refused without one unless Insecure is set; classic calls carry only an id,
+// so their resource must be fetched to be trusted. A call the sink fails to
+// handle after the retries is answered with an error, so Mollie delivers it
+// again.
+type WebhookHandler struct {
+	sink   Sink
+	secret string
+
+	// Retries is the number of times a failed sink call is retried
+	Retries int
+	// RetryDelay is the delay before the first retry, doubling per retry
+	RetryDelay time.Duration
+	// Insecure accepts event calls without verifying their signature when
+	// the handler has no secret, eg. in local development
+	Insecure bool
+}
+
+// NewWebhookHandler returns a WebhookHandler forwarding calls to sink and
+// verifying events with the webhook subscription secret. With an empty
+// secret event calls are refused, unless Insecure is set.
+func NewWebhookHandler(sink Sink, secret string) *WebhookHandler {
+	return &WebhookHandler{
+		sink:       sink,
+		secret:     secret,
+		Retries:    DefaultSinkRetries,
+		RetryDelay: DefaultSinkRetryDelay,
+	}
+}
+
+// ServeHTTP handles a webhook call
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	data, err := readWebhookBody(r)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+	call, err := parseWebhookBody(r.Header.Get("Content-Type"), data)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+	if call.Event != nil && !h.verify(data, r.Header.Get(signatureHeader)) {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	if err := h.deliver(r.Context(), call); err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// verify returns true if an event call with body and signature may be
+// handled
+func (h *WebhookHandler) verify(body []byte, signature string) bool {
+	if h.secret == "" {
+		return h.Insecure
+	}
+
+	return VerifySignature(body, signature, h.secret)
+}
+
+// deliver hands the call to the sink, retrying failures with backoff
+func (h *WebhookHandler) deliver(ctx context.Context, call WebhookCall) error {
+	delay := h.RetryDelay
+	err := h.sink.Handle(ctx, call)
+	for retry := 0; err != nil && retry < h.Retries; retry++ {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+		err = h.sink.Handle(ctx, call)
+	}
+
+	return err
+}
+
+// VerifySignature returns true if signature, the X-Mollie-Signature header
+// of an event webhook call, is the HMAC-SHA256 of body with secret
+func VerifySignature(body []byte, signature string, secret string) bool {
+	expected, err := hex.DecodeString(strings.TrimPrefix(signature, "sha256="))
+	if err != nil || len(expected) == 0 {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return hmac.Equal(mac.Sum(nil), expected)
+}
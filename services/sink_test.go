@@ -0,0 +1,63 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestWebhookHandlerVerification(t *testing.T) {
+	const secret = "whsec_test"
+	event := `{"resource":"event","id":"event_1","type":"payment-link.paid"}`
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(event))
+	signature := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	tests := []struct {
+		name        string
+		secret      string
+		insecure    bool
+		contentType string
+		body        string
+		signature   string
+		status      int
+	}{
+		{"signed event", secret, false, "application/json", event, signature, http.StatusOK},
+		{"bad signature", secret, false, "application/json", event, "sha256=00", http.StatusUnauthorized},
+		{"unsigned event", secret, false, "application/json", event, "", http.StatusUnauthorized},
+		{"no secret", "", false, "application/json", event, signature, http.StatusUnauthorized},
+		{"no secret, insecure", "", true, "application/json", event, "", http.StatusOK},
+		{"classic call, no secret", "", false, "application/x-www-form-urlencoded", "id=tr_1", "", http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var handled bool
+			handler := NewWebhookHandler(SinkFunc(func(ctx context.Context, call WebhookCall) error {
+				handled = true
+				return nil
+			}), tt.secret)
+			handler.Insecure = tt.insecure
+
+			r := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(tt.body))
+			r.Header.Set("Content-Type", tt.contentType)
+			if tt.signature != "" {
+				r.Header.Set("X-Mollie-Signature", tt.signature)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+
+			if w.Code != tt.status {
+				t.Errorf("got status %d, want %d", w.Code, tt.status)
+			}
+			if handled != (tt.status == http.StatusOK) {
+				t.Errorf("call handled %v with status %d", handled, w.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package services
+
+// Payment statuses
+// https://docs.mollie.com/payments/status-changes
+const (
+	PaymentStatusOpen       = "open"
+	PaymentStatusCanceled   = "canceled"
+	PaymentStatusPending    = "pending"
+	PaymentStatusAuthorized = "authorized"
+	PaymentStatusExpired    = "expired"
+	PaymentStatusFailed     = "failed"
+	PaymentStatusPaid       = "paid"
+)
+
+// paymentTransitions lists the statuses a payment can move to from a given status
+var paymentTransitions = map[string][]string{
+	PaymentStatusOpen: {
+		PaymentStatusCanceled,
+		PaymentStatusPending,
+		PaymentStatusAuthorized,
+		PaymentStatusExpired,
+		PaymentStatusFailed,
+		PaymentStatusPaid,
+	},
+	PaymentStatusPending: {
+		PaymentStatusCanceled,
+		PaymentStatusExpired,
+		PaymentStatusFailed,
+		PaymentStatusPaid,
+	},
+	PaymentStatusAuthorized: {
+		PaymentStatusCanceled,
+		PaymentStatusExpired,
+		PaymentStatusFailed,
+		PaymentStatusPaid,
+	},
+}
+
+// StatusTransition is the change in a payment status between two fetches,
+// eg. the stored status and the status fetched after a webhook call
+type StatusTransition struct {
+	PreviousStatus string
+	CurrentStatus  string
+}
+
+// NewStatusTransition returns a StatusTransition from previous to current
+func NewStatusTransition(previous string, current string) StatusTransition {
+	return StatusTransition{
+		PreviousStatus: previous,
+		CurrentStatus:  current,
+	}
+}
+
+// Changed returns true if the status has changed
+func (t StatusTransition) Changed() bool {
+	return t.PreviousStatus != t.CurrentStatus
+}
+
+// IsTransitionValid returns true if Mollie can move a payment from the
+// previous status to the current status. An unchanged status is valid, and
+// so is any status after an empty previous status, eg. for a payment not
+// stored before.
+func (t StatusTransition) IsTransitionValid() bool {
+	if !t.Changed() || t.PreviousStatus == "" {
+		return true
+	}
+	for _, status := range paymentTransitions[t.PreviousStatus] {
+		if status == t.CurrentStatus {
+			return true
+		}
+	}
+	return false
+}
+
+// ChangedToPaid returns true if the payment has just been paid
+func (t StatusTransition) ChangedToPaid() bool {
+	return t.Changed() && t.CurrentStatus == PaymentStatusPaid
+}
+
+// ChangedToFailed returns true if the payment has just failed
+func (t StatusTransition) ChangedToFailed() bool {
+	return t.Changed() && t.CurrentStatus == PaymentStatusFailed
+}
+
+// TransitionFrom returns the status transition from a previously known status
+func (p Payment) TransitionFrom(previous string) StatusTransition {
+	return NewStatusTransition(previous, p.Status)
+}
@@ -0,0 +1,27 @@
+package services
+
+import "testing"
+
+func TestIsTransitionValid(t *testing.T) {
+	tests := []struct {
+		previous string
+		current  string
+		valid    bool
+	}{
+		{PaymentStatusOpen, PaymentStatusPaid, true},
+		{PaymentStatusPending, PaymentStatusExpired, true},
+		{PaymentStatusAuthorized, PaymentStatusPaid, true},
+		{PaymentStatusPaid, PaymentStatusPaid, true},
+		{"", PaymentStatusOpen, true},
+		{"", PaymentStatusPaid, true},
+		{PaymentStatusPaid, PaymentStatusOpen, false},
+		{PaymentStatusPending, PaymentStatusAuthorized, false},
+		{PaymentStatusExpired, PaymentStatusPaid, false},
+	}
+
+	for _, tt := range tests {
+		if valid := NewStatusTransition(tt.previous, tt.current).IsTransitionValid(); valid != tt.valid {
+			t.Errorf("transition from %q to %q: got valid %v, want %v", tt.previous, tt.current, valid, tt.valid)
+		}
+	}
+}
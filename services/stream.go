@@ -0,0 +1,137 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/dghubble/sling"
+)
+
+// streamList calls fn for each item of the list at path, starting at params
+// and following all pages. Each page is decoded from the response body one
+// item at a time, so a page of MaxListLimit items is never held in memory.
+// An error returned by fn stops the stream and is returned.
+func streamList[T any](ctx context.Context, s *sling.Sling, doer sling.Doer, path string, params interface{}, fn func(*T) error, opts []RequestOption) error {
+	if err := validateParams(params); err != nil {
+		return err
+	}
+
+	o := newRequestOptions(contextOptions(ctx, opts))
+	req := o.apply(s.New().Get(path), false)
+	if !isNil(params) {
+		req = req.QueryStruct(params)
+	}
+
+	for {
+		meta, err := streamPage(req, doer, o, fn)
+		if err != nil {
+			return err
+		}
+		if !meta.HasNext() {
+			return nil
+		}
+
+		// The next link carries the query of the first page
+		o = &requestOptions{Context: o.Context, Timeout: o.Timeout}
+		req = s.New().Get(meta.Links.Next.Href)
+	}
+}
+
+// streamPage sends the list request built on s, calling fn for each item in
+// the _embedded object of the response, and returns the list metadata
+func streamPage[T any](s *sling.Sling, doer sling.Doer, o *requestOptions, fn func(*T) error) (ListMetadata, error) {
+	var meta ListMetadata
+
+	req, err := s.Request()
+	if err != nil {
+		return meta, err
+	}
+	resp, err := doer.Do(req.WithContext(o.context()))
+	if err != nil {
+		return meta, err
+	}
+	defer resp.Body.Close()
+
+	if err := readResponseError(resp); err != nil {
+		return meta, err
+	}
+
+	dec := json.NewDecoder(resp.Body)
+	err = decodeObject(dec, func(key string) error {
+		switch key {
+		case "_embedded":
+			return decodeObject(dec, func(string) error {
+				return decodeArray(dec, fn)
+			})
+		case "count":
+			return dec.Decode(&meta.Count)
+		case "_links":
+			return dec.Decode(&meta.Links)
+		}
+
+		var skip json.RawMessage
+		return dec.Decode(&skip)
+	})
+
+	return meta, err
+}
+
+// decodeObject reads a JSON object from dec, calling fn to decode the value
+// of each key. A null object is skipped.
+func decodeObject(dec *json.Decoder, fn func(key string) error) error {
+	if ok, err := readOpen(dec, '{'); !ok || err != nil {
+		return err
+	}
+
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		if err := fn(token.(string)); err != nil {
+			return err
+		}
+	}
+
+	_, err := dec.Token()
+	return err
+}
+
+// decodeArray reads a JSON array from dec, decoding and passing each item
+// to fn. A null array is skipped.
+func decodeArray[T any](dec *json.Decoder, fn func(*T) error) error {
+	if ok, err := readOpen(dec, '['); !ok || err != nil {
+		return err
+	}
+
+	for dec.More() {
+		item := new(T)
+		if err := dec.Decode(item); err != nil {
+			return err
+		}
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+
+	_, err := dec.Token()
+	return err
+}
+
+// readOpen reads the opening delim of an object or array from dec, returning
+// false for null
+func readOpen(dec *json.Decoder, delim json.Delim) (bool, error) {
+	token, err := dec.Token()
+	if err != nil {
+		return false, err
+	}
+	if token == nil {
+		return false, nil
+	}
+	if token != delim {
+		return false, fmt.Errorf("decoding list: expected %v, got %v", delim, token)
+	}
+
+	return true, nil
+}
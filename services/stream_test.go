@@ -0,0 +1,92 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestPaymentStream(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		want    []string
+		wantErr bool
+	}{
+		{"items", http.StatusOK, `{"count":2,"_embedded":{"payments":[{"id":"tr_1"},{"id":"tr_2"}]}}`, []string{"tr_1", "tr_2"}, false},
+		{"links first", http.StatusOK, `{"_links":{"self":{"href":"x"},"next":null},"extra":{"a":[1,{"b":2}]},"_embedded":{"payments":[{"id":"tr_1"}]},"count":1}`, []string{"tr_1"}, false},
+		{"empty list", http.StatusOK, `{"count":0,"_embedded":{"payments":[]}}`, nil, false},
+		{"missing _embedded", http.StatusOK, `{"count":0,"_links":{"next":null}}`, nil, false},
+		{"null _embedded", http.StatusOK, `{"count":0,"_embedded":null}`, nil, false},
+		{"empty _embedded", http.StatusOK, `{"count":0,"_embedded":{}}`, nil, false},
+		{"null items", http.StatusOK, `{"count":0,"_embedded":{"payments":null}}`, nil, false},
+		{"_embedded not an object", http.StatusOK, `{"_embedded":[]}`, nil, true},
+		{"items not an array", http.StatusOK, `{"_embedded":{"payments":{"id":"tr_1"}}}`, nil, true},
+		{"truncated", http.StatusOK, `{"_embedded":{"payments":[{"id":"tr_1"}`, []string{"tr_1"}, true},
+		{"error response", http.StatusUnauthorized, `{"status":401,"title":"Unauthorized Request"}`, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payments := NewPaymentService("test_x", withTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				writeJSON(w, tt.status, tt.body)
+			}))
+
+			var got []string
+			err := payments.Stream(context.Background(), nil, func(p *Payment) error {
+				got = append(got, p.ID)
+				return nil
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got payments %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPaymentStreamPages(t *testing.T) {
+	payments := NewPaymentService("test_x", withTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("from") {
+		case "":
+			writeJSON(w, http.StatusOK, `{"count":1,"_embedded":{"payments":[{"id":"tr_1"}]},
+				"_links":{"next":{"href":"https://api.mollie.com/v2/payments?from=tr_2&limit=1"}}}`)
+		case "tr_2":
+			writeJSON(w, http.StatusOK, `{"count":1,"_embedded":{"payments":[{"id":"tr_2"}]},
+				"_links":{"next":{"href":"https://api.mollie.com/v2/payments?from=tr_3&limit=1"}}}`)
+		default:
+			writeJSON(w, http.StatusOK, `{"count":0,"_links":{"next":null}}`)
+		}
+	}))
+
+	var got []string
+	err := payments.Stream(context.Background(), &ListParams{Limit: 1}, func(p *Payment) error {
+		got = append(got, p.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"tr_1", "tr_2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got payments %v, want %v", got, want)
+	}
+
+	// An error returned by fn stops the stream
+	stop := errors.New("stop")
+	got = nil
+	err = payments.Stream(context.Background(), nil, func(p *Payment) error {
+		got = append(got, p.ID)
+		return stop
+	})
+	if err != stop {
+		t.Errorf("got error %v, want %v", err, stop)
+	}
+	if want := []string{"tr_1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got payments %v, want %v", got, want)
+	}
+}
@@ -1,12 +1,12 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/dghubble/sling"
-	"github.com/rollick/decimal"
 )
 
 // SubscriptionService provides methods for accessing subscription records.
@@ -17,46 +17,135 @@ type SubscriptionService struct {
 // Subscription is a subscription object
 // https://www.mollie.com/nl/docs/reference/subscriptions/get#response
 type Subscription struct {
-	Resource    string          `json:"resource"`
-	ID          string          `json:"id"`
-	Description string          `json:"description"`
-	Amount      decimal.Decimal `json:"amount"`
-	Interval    string          `json:"interval"`
-	Times       int             `json:"times"`
-	Mode        string          `json:"mode"`
-	Method      string          `json:"method"`
-	Status      string          `json:"status"`
-	Locale      string          `json:"locale"`
-	ProfileID   string          `json:"profileId"`
-	CustomerID  string          `json:"customerId"`
-	CancelledAt *time.Time      `json:"cancelledDatetime"`
-	CreatedAt   *time.Time      `json:"createdDatetime"`
-	StartDate   string          `json:"startDate"`
-	Links       PaymentLinks    `json:"links"`
+	Resource        string            `json:"resource" bson:"resource"`
+	ID              string            `json:"id" bson:"id"`
+	Description     string            `json:"description" bson:"description"`
+	Amount          Amount            `json:"amount" bson:"amount"`
+	Interval        Interval          `json:"interval" bson:"interval"`
+	Times           int               `json:"times" bson:"times"`
+	TimesRemaining  int               `json:"timesRemaining" bson:"timesRemaining"`
+	Mode            string            `json:"mode" bson:"mode"`
+	Method          string            `json:"method" bson:"method"`
+	Status          string            `json:"status" bson:"status"`
+	Locale          string            `json:"locale" bson:"locale"`
+	ProfileID       string            `json:"profileId" bson:"profileId"`
+	CustomerID      string            `json:"customerId" bson:"customerId"`
+	CanceledAt      *time.Time        `json:"canceledAt" bson:"canceledAt"`
+	CreatedAt       *time.Time        `json:"createdAt" bson:"createdAt"`
+	StartDate       *Date             `json:"startDate" bson:"startDate"`
+	NextPaymentDate *Date             `json:"nextPaymentDate" bson:"nextPaymentDate"`
+	Links           SubscriptionLinks `json:"_links" bson:"_links"`
+}
+
+// Subscription statuses
+// https://docs.mollie.com/reference/v2/subscriptions-api/get-subscription#response
+const (
+	SubscriptionStatusPending   = "pending"
+	SubscriptionStatusActive    = "active"
+	SubscriptionStatusCanceled  = "canceled"
+	SubscriptionStatusSuspended = "suspended"
+	SubscriptionStatusCompleted = "completed"
+)
+
+// SubscriptionForecast is the upcoming charges of a subscription
+type SubscriptionForecast struct {
+	// Dates are the upcoming charge dates, in order
+	Dates []Date
+	// Remaining is the number of charges left, or -1 if the subscription
+	// runs until it is canceled
+	Remaining int
+}
+
+// Forecast returns up to max upcoming charge dates of the subscription on or
+// after from, computed from the start date, interval and times. Canceled and
+// completed subscriptions have no upcoming charges.
+func (s Subscription) Forecast(from time.Time, max int) SubscriptionForecast {
+	var forecast SubscriptionForecast
+	if s.Status == SubscriptionStatusCanceled || s.Status == SubscriptionStatusCompleted {
+		return forecast
+	}
+
+	var start Date
+	switch {
+	case s.StartDate != nil:
+		start = *s.StartDate
+	case s.CreatedAt != nil:
+		start = DateOf(*s.CreatedAt)
+	default:
+		return forecast
+	}
+
+	// Charge k is scaled from the start so month ends do not drift
+	charge := func(k int) Date {
+		return Date{Interval{Count: k * s.Interval.Count, Unit: s.Interval.Unit}.Next(start.Time)}
+	}
+	first := DateOf(from)
+	recurs := s.Interval.Validate() == nil
+	k := 0
+	if recurs {
+		for charge(k).Before(first.Time) {
+			k++
+		}
+	}
+
+	forecast.Remaining = -1
+	if s.Times > 0 {
+		forecast.Remaining = s.Times - k
+		if forecast.Remaining < 0 {
+			forecast.Remaining = 0
+		}
+	}
+	for ; len(forecast.Dates) < max && (forecast.Remaining < 0 || len(forecast.Dates) < forecast.Remaining); k++ {
+		forecast.Dates = append(forecast.Dates, charge(k))
+		if !recurs {
+			break
+		}
+	}
+
+	return forecast
+}
+
+// SubscriptionLinks represents the _links object returned in a Subscription
+// https://docs.mollie.com/reference/v2/subscriptions-api/get-subscription#response
+type SubscriptionLinks struct {
+	Self          Link `json:"self" bson:"self"`
+	Dashboard     Link `json:"dashboard" bson:"dashboard"`
+	Customer      Link `json:"customer" bson:"customer"`
+	Payments      Link `json:"payments" bson:"payments"`
+	Documentation Link `json:"documentation" bson:"documentation"`
+}
+
+// String summarizes the subscription for logging
+func (s Subscription) String() string {
+	return fmt.Sprintf("subscription %s (%s, %s every %s)", s.ID, s.Status, s.Amount, s.Interval)
+}
+
+// DashboardURL returns the URL of the subscription in the Mollie dashboard,
+// or "" if Mollie did not return a dashboard link
+func (s Subscription) DashboardURL() string {
+	return s.Links.Dashboard.Href
 }
 
 // SubscriptionList is a list of subscription objects and list metadata
 // https://www.mollie.com/nl/docs/reference/subscriptions/list#response
-type SubscriptionList struct {
-	Data         []*Subscription `json:"data"`
-	ListMetadata `bson:",inline"`
-}
+type SubscriptionList = List[*Subscription]
 
 // SubscriptionRequest is a subscription create request
 // https://www.mollie.com/nl/docs/reference/subscriptions/create#parameters
 type SubscriptionRequest struct {
-	Amount      decimal.Decimal `json:"amount,omitempty"`
-	Times       int             `json:"times,omitempty"`
-	Interval    string          `json:"interval,omitempty"`
-	StartDate   string          `json:"startDate,omitempty"`
-	Description string          `json:"description,omitempty"`
-	Method      string          `json:"method,omitempty"`
-	WebhookUrl  string          `json:"webhookUrl,omitempty"`
+	Amount      Amount   `json:"amount" bson:"amount"`
+	Times       int      `json:"times,omitempty" bson:"times,omitempty"`
+	Interval    Interval `json:"interval" bson:"interval"`
+	StartDate   *Date    `json:"startDate,omitempty" bson:"startDate,omitempty"`
+	Description string   `json:"description,omitempty" bson:"description,omitempty"`
+	Method      string   `json:"method,omitempty" bson:"method,omitempty"`
+	MandateID   string   `json:"mandateId,omitempty" bson:"mandateId,omitempty"`
+	WebhookUrl  string   `json:"webhookUrl,omitempty" bson:"webhookUrl,omitempty"`
 }
 
 // NewSubscriptionService returns a new SubscriptionService.
-func NewSubscriptionService(accessToken string) *SubscriptionService {
-	client := NewClient(accessToken)
+func NewSubscriptionService(accessToken string, opts ...ClientOption) *SubscriptionService {
+	client := NewClient(accessToken, opts...)
 
 	return &SubscriptionService{
 		sling: client,
@@ -64,35 +153,56 @@ func NewSubscriptionService(accessToken string) *SubscriptionService {
 }
 
 // List returns all subscriptions created.
-func (s *SubscriptionService) List(customerId string, params *ListParams) (SubscriptionList, *http.Response, error) {
-	subscriptions := new(SubscriptionList)
-	mollieError := new(MollieError)
-	resp, err := s.sling.New().Path(fmt.Sprintf("customers/%s/subscriptions", customerId)).QueryStruct(params).Receive(subscriptions, mollieError)
-	if err == nil && mollieError.Err.Type != "" {
-		err = mollieError
+func (s *SubscriptionService) List(customerId string, params *ListParams, opts ...RequestOption) (SubscriptionList, *http.Response, error) {
+	return doGet[SubscriptionList](s.sling, fmt.Sprintf("customers/%s/subscriptions", customerId), params, opts...)
+}
+
+// Fetch returns a created subscription
+func (s *SubscriptionService) Fetch(customerId string, subscriptionId string, opts ...RequestOption) (Subscription, *http.Response, error) {
+	return doGet[Subscription](s.sling, fmt.Sprintf("customers/%s/subscriptions/%s", customerId, subscriptionId), nil, opts...)
+}
+
+// Create creates a new subscription
+func (s *SubscriptionService) Create(customerId string, subscriptionBody *SubscriptionRequest, opts ...RequestOption) (Subscription, *http.Response, error) {
+	if subscriptionBody == nil {
+		return Subscription{}, nil, errRequiredBody
+	}
+	if err := subscriptionBody.Amount.Validate(); err != nil {
+		return Subscription{}, nil, err
 	}
 
-	return *subscriptions, resp, err
+	return doPost[Subscription](s.sling, fmt.Sprintf("customers/%s/subscriptions", customerId), subscriptionBody, opts...)
 }
 
-// Fetch returns a created subscription
-func (s *SubscriptionService) Fetch(customerId string, subscriptionId string) (Subscription, *http.Response, error) {
-	subscription := new(Subscription)
-	mollieError := new(MollieError)
-	resp, err := s.sling.New().Get(fmt.Sprintf("customers/%s/subscriptions/%s", customerId, subscriptionId)).Receive(subscription, mollieError)
-	if err == nil && mollieError.Err.Type != "" {
-		err = mollieError
+// Cancel cancels a subscription
+func (s *SubscriptionService) Cancel(customerId string, subscriptionId string, opts ...RequestOption) (Subscription, *http.Response, error) {
+	return doDelete[Subscription](s.sling, fmt.Sprintf("customers/%s/subscriptions/%s", customerId, subscriptionId), opts...)
+}
+
+// Iter returns an iterator over all subscriptions for a customer, starting at params
+func (s *SubscriptionService) Iter(customerId string, params *ListParams, opts ...RequestOption) *SubscriptionIterator {
+	it := new(SubscriptionIterator)
+	it.load = func(next string) (SubscriptionList, error) {
+		if next == "" {
+			page, _, err := s.List(customerId, params, opts...)
+			return page, err
+		}
+		page, _, err := doGetNext[SubscriptionList](s.sling, next, it.ctx, opts)
+		return page, err
 	}
-	return *subscription, resp, err
+
+	return it
 }
 
-// Create creates a new subscription
-func (s *SubscriptionService) Create(customerId string, subscriptionBody *SubscriptionRequest) (Subscription, *http.Response, error) {
-	subscription := new(Subscription)
-	mollieError := new(MollieError)
-	resp, err := s.sling.New().Post(fmt.Sprintf("customers/%s/subscriptions", customerId)).BodyJSON(subscriptionBody).Receive(subscription, mollieError)
-	if err == nil && mollieError.Err.Type != "" {
-		err = mollieError
+// All returns all subscriptions for a customer, following all pages up to MaxAllPages
+func (s *SubscriptionService) All(ctx context.Context, customerId string, params *ListParams, opts ...RequestOption) ([]*Subscription, error) {
+	it := s.Iter(customerId, params, contextOptions(ctx, opts)...)
+	it.limit(ctx, MaxAllPages)
+
+	var subscriptions []*Subscription
+	for it.Next() {
+		subscriptions = append(subscriptions, it.Subscription())
 	}
-	return *subscription, resp, err
+
+	return subscriptions, it.Err()
 }
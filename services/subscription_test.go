@@ -0,0 +1,64 @@
+package services
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSubscriptionForecast(t *testing.T) {
+	start := NewDate(2024, time.January, 31)
+
+	tests := []struct {
+		name         string
+		subscription Subscription
+		from         time.Time
+		max          int
+		want         []Date
+		remaining    int
+	}{
+		{
+			name:         "month ends do not drift",
+			subscription: Subscription{Status: SubscriptionStatusActive, Interval: Interval{1, IntervalMonths}, StartDate: &start},
+			from:         start.Time,
+			max:          4,
+			want: []Date{
+				NewDate(2024, time.January, 31),
+				NewDate(2024, time.February, 29),
+				NewDate(2024, time.March, 31),
+				NewDate(2024, time.April, 30),
+			},
+			remaining: -1,
+		},
+		{
+			name:         "from a later date",
+			subscription: Subscription{Status: SubscriptionStatusActive, Interval: Interval{2, IntervalWeeks}, StartDate: &start, Times: 3},
+			from:         time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+			max:          5,
+			want: []Date{
+				NewDate(2024, time.February, 14),
+				NewDate(2024, time.February, 28),
+			},
+			remaining: 2,
+		},
+		{
+			name:         "canceled",
+			subscription: Subscription{Status: SubscriptionStatusCanceled, Interval: Interval{1, IntervalMonths}, StartDate: &start},
+			from:         start.Time,
+			max:          3,
+			remaining:    0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			forecast := tt.subscription.Forecast(tt.from, tt.max)
+			if !reflect.DeepEqual(forecast.Dates, tt.want) {
+				t.Errorf("got dates %v, want %v", forecast.Dates, tt.want)
+			}
+			if forecast.Remaining != tt.remaining {
+				t.Errorf("got %d remaining, want %d", forecast.Remaining, tt.remaining)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/dghubble/sling"
+)
+
+// WithDefaultTestmode makes every request in test mode, as WithTestmode does
+// for a single request. It is for organization access tokens; API keys
+// select the mode themselves.
+func WithDefaultTestmode() ClientOption {
+	return func(c *clientConfig) {
+		c.middleware = append(c.middleware, withTestmode)
+	}
+}
+
+// withTestmode returns a Doer sending requests with testmode set, in the
+// query of requests without a body and in the JSON body of the others.
+// Requests to other hosts than the Mollie API, such as signed document URLs,
+// are sent unchanged.
+func withTestmode(next sling.Doer) sling.Doer {
+	return doerFunc(func(req *http.Request) (*http.Response, error) {
+		if !isAPIRequest(req) {
+			return next.Do(req)
+		}
+
+		req = req.Clone(req.Context())
+		if req.Method == http.MethodGet || req.Method == http.MethodHead {
+			query := req.URL.Query()
+			query.Set("testmode", "true")
+			req.URL.RawQuery = query.Encode()
+			return next.Do(req)
+		}
+
+		fields := make(map[string]json.RawMessage)
+		if req.Body != nil && req.Body != http.NoBody {
+			data, err := io.ReadAll(req.Body)
+			req.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+			if len(bytes.TrimSpace(data)) > 0 {
+				if err := json.Unmarshal(data, &fields); err != nil {
+					return nil, err
+				}
+			}
+		}
+		fields["testmode"] = json.RawMessage("true")
+
+		data, err := json.Marshal(fields)
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(data))
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(data)), nil
+		}
+		req.ContentLength = int64(len(data))
+		req.Header.Set("Content-Type", "application/json")
+
+		return next.Do(req)
+	})
+}
@@ -0,0 +1,65 @@
+package services
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/dghubble/sling"
+)
+
+// DefaultTimeout is the default timeout of a request, including reading
+// the response body
+const DefaultTimeout = 30 * time.Second
+
+// timeoutKey is the request context key of a per request timeout
+type timeoutKey struct{}
+
+// WithTimeout sets the timeout of requests, zero for no timeout. It can be
+// overridden per request with WithRequestTimeout.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *clientConfig) {
+		c.timeout = timeout
+	}
+}
+
+// withTimeout returns a Doer applying the request timeout, or timeout if the
+// request does not set one
+func withTimeout(next sling.Doer, timeout time.Duration) sling.Doer {
+	return doerFunc(func(req *http.Request) (*http.Response, error) {
+		d := timeout
+		if override, ok := req.Context().Value(timeoutKey{}).(time.Duration); ok {
+			d = override
+		}
+		if d <= 0 {
+			return next.Do(req)
+		}
+
+		ctx, cancel := context.WithTimeout(req.Context(), d)
+		resp, err := next.Do(req.WithContext(ctx))
+		if err != nil {
+			cancel()
+			return resp, err
+		}
+
+		// Keep the deadline running while the body is read
+		resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+
+		return resp, nil
+	})
+}
+
+// cancelBody is a response body cancelling its request context when closed
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// Close closes the body and cancels the request context
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+
+	return err
+}
@@ -0,0 +1,71 @@
+package services
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/dghubble/sling"
+)
+
+// maxErrorSnippet is the number of bytes of a non-JSON error body kept in a
+// TransportError
+const maxErrorSnippet = 512
+
+// TransportError is returned for an error response that does not come from
+// the Mollie API, such as the HTML error page of a proxy or the Mollie edge
+// on a 502 or 504
+type TransportError struct {
+	// Snippet is the start of the response body
+	Snippet string
+
+	// Response is the metadata of the error response
+	Response ResponseInfo
+}
+
+// Error is a formatted transport error
+func (e *TransportError) Error() string {
+	msg := fmt.Sprintf("unexpected %d %s response from Mollie", e.Response.StatusCode, http.StatusText(e.Response.StatusCode))
+	if e.Response.ContentType != "" {
+		msg = fmt.Sprintf("%s (%s)", msg, e.Response.ContentType)
+	}
+	if e.Snippet != "" {
+		msg = fmt.Sprintf("%s: %q", msg, e.Snippet)
+	}
+
+	return msg
+}
+
+// withTransportErrors returns a Doer failing error responses that are not
+// JSON with a TransportError, instead of leaving them to the JSON decoder.
+// Rate limit responses are left to the rate limit handling.
+func withTransportErrors(next sling.Doer) sling.Doer {
+	return doerFunc(func(req *http.Request) (*http.Response, error) {
+		resp, err := next.Do(req)
+		if err != nil || resp.StatusCode < 300 || resp.StatusCode == http.StatusTooManyRequests || isJSON(resp.Header.Get("Content-Type")) {
+			return resp, err
+		}
+
+		snippet, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorSnippet))
+		resp.Body.Close()
+		resp.Body = io.NopCloser(strings.NewReader(""))
+
+		return resp, &TransportError{
+			Snippet:  strings.TrimSpace(string(snippet)),
+			Response: NewResponseInfo(resp),
+		}
+	})
+}
+
+// isJSON returns true if contentType is JSON, including the Mollie
+// application/hal+json type
+func isJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
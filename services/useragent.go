@@ -0,0 +1,56 @@
+package services
+
+import (
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"strings"
+)
+
+// modulePath is the module path of this package, to find its version in the
+// build information
+const modulePath = "github.com/rollick/gollie"
+
+// WithUserAgent adds an application identifier, eg. "MyShop/2.1", to the
+// User-Agent sent to Mollie
+func WithUserAgent(application string) ClientOption {
+	return func(c *clientConfig) {
+		c.userAgent = application
+	}
+}
+
+// userAgent returns the User-Agent header identifying this package, the Go
+// version and the application, if any
+func userAgent(application string) string {
+	ua := fmt.Sprintf("Gollie/%s Go/%s", moduleVersion(), strings.TrimPrefix(runtime.Version(), "go"))
+	if application != "" {
+		ua = fmt.Sprintf("%s %s", ua, application)
+	}
+
+	return ua
+}
+
+// moduleVersion returns the version of this package from the build
+// information, or "devel" if it is not known
+func moduleVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "devel"
+	}
+
+	module := &info.Main
+	if module.Path != modulePath {
+		module = nil
+		for _, dep := range info.Deps {
+			if dep.Path == modulePath {
+				module = dep
+				break
+			}
+		}
+	}
+	if module == nil || module.Version == "" || module.Version == "(devel)" {
+		return "devel"
+	}
+
+	return strings.TrimPrefix(module.Version, "v")
+}
@@ -0,0 +1,116 @@
+package services
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/rollick/decimal"
+)
+
+// ValidationError is returned when a request fails client-side validation
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+// Error is a formatted validation error
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("invalid %s: %s", e.Field, e.Message)
+}
+
+// Validate checks the payment request for errors Mollie would reject it for
+func (r PaymentRequest) Validate() error {
+	if err := r.Amount.Validate(); err != nil {
+		return ValidationError{Field: "amount", Message: err.Error()}
+	}
+	if err := validatePositiveAmount(r.Amount); err != nil {
+		return err
+	}
+	if r.Description == "" {
+		return ValidationError{Field: "description", Message: "is required"}
+	}
+
+	if r.SequenceType != "" && !r.SequenceType.Valid() {
+		return ValidationError{Field: "sequenceType", Message: fmt.Sprintf("unknown sequence type %q", string(r.SequenceType))}
+	}
+	if r.SequenceType == SequenceTypeRecurring {
+		if r.CustomerID == "" {
+			return ValidationError{Field: "customerId", Message: "is required for recurring payments"}
+		}
+	} else if r.RedirectUrl == "" {
+		return ValidationError{Field: "redirectUrl", Message: "is required"}
+	}
+
+	if r.WebhookUrl != "" {
+		if err := validateURL(r.WebhookUrl); err != nil {
+			return ValidationError{Field: "webhookUrl", Message: err.Error()}
+		}
+	}
+
+	if r.Method == MethodBillie {
+		if r.BillingAddress == nil || r.BillingAddress.OrganizationName == "" {
+			return ValidationError{Field: "billingAddress.organizationName", Message: "is required for Billie payments"}
+		}
+		if r.Company == nil || r.Company.RegistrationNumber == "" && r.Company.VatNumber == "" {
+			return ValidationError{Field: "company", Message: "registration or VAT number is required for Billie payments"}
+		}
+	}
+
+	for i, line := range r.Lines {
+		if err := line.Validate(); err != nil {
+			if v, ok := err.(ValidationError); ok {
+				v.Field = fmt.Sprintf("lines[%d].%s", i, v.Field)
+				return v
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validatePositiveAmount checks amount is at least the smallest unit of its
+// currency. The minimum of each method is left to Mollie, as it differs per
+// profile and changes over time; MethodService.List returns it.
+func validatePositiveAmount(amount Amount) error {
+	minimum := decimal.New(1, -Decimals(amount.Currency))
+	if amount.mustDecimal().Cmp(minimum) < 0 {
+		return ValidationError{Field: "amount", Message: fmt.Sprintf("must be at least %s", minimum.StringFixed(Decimals(amount.Currency)))}
+	}
+
+	return nil
+}
+
+// errRequiredBody is returned when creating a resource with a nil request
+var errRequiredBody = ValidationError{Field: "request", Message: "is required"}
+
+// validateURL checks u is an absolute http(s) URL
+func validateURL(u string) error {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return err
+	}
+	if (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("%q is not an absolute http(s) URL", u)
+	}
+
+	return nil
+}
+
+// Validate checks the line quantity is positive and the line type and
+// categories are known to Mollie
+func (l PaymentLine) Validate() error {
+	if l.Quantity < 1 {
+		return ValidationError{Field: "quantity", Message: "must be at least 1"}
+	}
+	if l.Type != "" && !l.Type.Valid() {
+		return ValidationError{Field: "type", Message: fmt.Sprintf("unknown line type %q", string(l.Type))}
+	}
+	for _, category := range l.Categories {
+		if !category.Valid() {
+			return ValidationError{Field: "categories", Message: fmt.Sprintf("unknown line category %q", string(category))}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package services
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestCreateNilRequest(t *testing.T) {
+	opt := withTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+	payments := NewPaymentService("test_x", opt)
+	transfers := NewBalanceTransferService("test_x", opt)
+	paymentLinks := NewPaymentLinkService("test_x", opt)
+	subscriptions := NewSubscriptionService("test_x", opt)
+	webhooks := NewWebhookService("test_x", opt)
+
+	tests := []struct {
+		name   string
+		create func() error
+	}{
+		{"PaymentService.Create", func() error {
+			_, _, err := payments.Create(nil)
+			return err
+		}},
+		{"PaymentService.CreateRefund", func() error {
+			_, _, err := payments.CreateRefund("tr_1", nil)
+			return err
+		}},
+		{"PaymentService.CreateCaptureRefund", func() error {
+			_, _, err := payments.CreateCaptureRefund("tr_1", "cpt_1", nil)
+			return err
+		}},
+		{"BalanceTransferService.Create", func() error {
+			_, _, err := transfers.Create(nil)
+			return err
+		}},
+		{"PaymentLinkService.Create", func() error {
+			_, _, err := paymentLinks.Create(nil)
+			return err
+		}},
+		{"SubscriptionService.Create", func() error {
+			_, _, err := subscriptions.Create("cst_1", nil)
+			return err
+		}},
+		{"WebhookService.Create", func() error {
+			_, _, err := webhooks.Create(nil)
+			return err
+		}},
+		{"WebhookService.Update", func() error {
+			_, _, err := webhooks.Update("hook_1", nil)
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		var validation ValidationError
+		if err := tt.create(); !errors.As(err, &validation) {
+			t.Errorf("%s(nil) = %v, want a ValidationError", tt.name, err)
+		}
+	}
+}
+
+func TestPaymentRequestValidateAmount(t *testing.T) {
+	tests := []struct {
+		amount Amount
+		valid  bool
+	}{
+		{MustAmount("EUR", "0.01"), true},
+		{MustAmount("EUR", "0.00"), false},
+		{MustAmount("EUR", "-1.00"), false},
+		{MustAmount("JPY", "1"), true},
+		{MustAmount("JPY", "0"), false},
+		{Amount{Currency: "EUR", Number: "1"}, false},
+		{Amount{Currency: "eur", Number: "1.00"}, false},
+	}
+
+	for _, tt := range tests {
+		r := PaymentRequest{
+			Amount:      tt.amount,
+			Description: "Order 1",
+			RedirectUrl: "https://example.com/return",
+			Method:      MethodEPS,
+		}
+		err := r.Validate()
+		if valid := err == nil; valid != tt.valid {
+			t.Errorf("%s %q: got error %v, want valid %v", tt.amount.Currency, tt.amount.Number, err, tt.valid)
+		}
+	}
+}
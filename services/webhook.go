@@ -0,0 +1,118 @@
+package services
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/dghubble/sling"
+)
+
+// Webhook statuses
+// https://docs.mollie.com/reference/get-webhook
+const (
+	WebhookStatusEnabled  = "enabled"
+	WebhookStatusBlocked  = "blocked"
+	WebhookStatusDisabled = "disabled"
+	WebhookStatusDeleted  = "deleted"
+)
+
+// Webhook is a webhook subscription, delivering events of the subscribed
+// types to a URL
+// https://docs.mollie.com/reference/get-webhook
+type Webhook struct {
+	Resource      string       `json:"resource" bson:"resource"`
+	ID            string       `json:"id" bson:"id"`
+	URL           string       `json:"url" bson:"url"`
+	ProfileID     string       `json:"profileId" bson:"profileId"`
+	CreatedAt     *time.Time   `json:"createdAt" bson:"createdAt"`
+	Name          string       `json:"name" bson:"name"`
+	EventTypes    []string     `json:"eventTypes" bson:"eventTypes"`
+	Status        string       `json:"status" bson:"status"`
+	Mode          string       `json:"mode" bson:"mode"`
+	WebhookSecret string       `json:"webhookSecret,omitempty" bson:"webhookSecret,omitempty"`
+	Links         WebhookLinks `json:"_links" bson:"_links"`
+}
+
+// WebhookLinks represents the _links object returned in a Webhook
+type WebhookLinks struct {
+	Self          Link `json:"self" bson:"self"`
+	Documentation Link `json:"documentation" bson:"documentation"`
+}
+
+// WebhookRequest is a webhook create or update request. Empty fields are
+// left unchanged by an update.
+// https://docs.mollie.com/reference/create-webhook
+type WebhookRequest struct {
+	Name       string   `json:"name,omitempty" bson:"name,omitempty"`
+	URL        string   `json:"url,omitempty" bson:"url,omitempty"`
+	EventTypes []string `json:"eventTypes,omitempty" bson:"eventTypes,omitempty"`
+}
+
+// WebhookList is a list of webhook objects and list metadata
+// https://docs.mollie.com/reference/list-webhooks
+type WebhookList = List[*Webhook]
+
+// WebhookService provides methods for managing webhook subscriptions.
+type WebhookService struct {
+	sling *sling.Sling
+}
+
+// NewWebhookService returns a new WebhookService.
+func NewWebhookService(accessToken string, opts ...ClientOption) *WebhookService {
+	// Create mollie api client
+	client := NewClient(accessToken, opts...)
+
+	return &WebhookService{
+		sling: client,
+	}
+}
+
+// List returns the webhook subscriptions
+func (s *WebhookService) List(params *ListParams, opts ...RequestOption) (WebhookList, *http.Response, error) {
+	return doGet[WebhookList](s.sling, "webhooks", params, opts...)
+}
+
+// Get returns a webhook subscription
+func (s *WebhookService) Get(webhookId string, opts ...RequestOption) (Webhook, *http.Response, error) {
+	return doGet[Webhook](s.sling, fmt.Sprintf("webhooks/%s", webhookId), nil, opts...)
+}
+
+// Create creates a webhook subscription. The returned webhook holds the
+// secret to verify deliveries with, which is only returned on creation.
+func (s *WebhookService) Create(webhookBody *WebhookRequest, opts ...RequestOption) (Webhook, *http.Response, error) {
+	if webhookBody == nil {
+		return Webhook{}, nil, errRequiredBody
+	}
+	if err := validateURL(webhookBody.URL); err != nil {
+		return Webhook{}, nil, ValidationError{Field: "url", Message: err.Error()}
+	}
+
+	return doPost[Webhook](s.sling, "webhooks", webhookBody, opts...)
+}
+
+// Update updates the name, URL or event types of a webhook subscription
+func (s *WebhookService) Update(webhookId string, webhookBody *WebhookRequest, opts ...RequestOption) (Webhook, *http.Response, error) {
+	if webhookBody == nil {
+		return Webhook{}, nil, errRequiredBody
+	}
+	if webhookBody.URL != "" {
+		if err := validateURL(webhookBody.URL); err != nil {
+			return Webhook{}, nil, ValidationError{Field: "url", Message: err.Error()}
+		}
+	}
+
+	return doPatch[Webhook](s.sling, fmt.Sprintf("webhooks/%s", webhookId), webhookBody, opts...)
+}
+
+// Delete deletes a webhook subscription
+func (s *WebhookService) Delete(webhookId string, opts ...RequestOption) (*http.Response, error) {
+	_, resp, err := doDelete[struct{}](s.sling, fmt.Sprintf("webhooks/%s", webhookId), opts...)
+	return resp, err
+}
+
+// Test sends a test event to the URL of a webhook subscription
+func (s *WebhookService) Test(webhookId string, opts ...RequestOption) (*http.Response, error) {
+	_, resp, err := doPost[struct{}](s.sling, fmt.Sprintf("webhooks/%s/ping", webhookId), nil, opts...)
+	return resp, err
+}